@@ -0,0 +1,168 @@
+package main
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"shared/models"
+	"shared/pii"
+)
+
+//Conversions between the models stored here and the models package's, which are what is sent and responded with.
+//Fields that can't be set by requests, like Available, are only converted to the models package's
+
+func (driver Driver) toApi() models.Driver {
+	return models.Driver{
+		Id:               driver.Id,
+		FirstName:        driver.FirstName,
+		LastName:         driver.LastName,
+		MobileNo:         int(driver.MobileNo),
+		Email:            string(driver.Email),
+		CarLicenseNo:     driver.CarLicenseNo,
+		Available:        driver.Available,
+		ActiveVehicleId:  driver.ActiveVehicleId,
+		Online:           driver.Online,
+		ActiveTripId:     driver.ActiveTripId,
+		LastActiveAt:     driver.LastActiveAt,
+		OnboardingStatus: driver.OnboardingStatus,
+		OnboardingNote:   driver.OnboardingNote,
+		Version:          driver.Version,
+		DeletedAt:        deletedAtTime(driver.DeletedAt),
+		AnonymisedAt:     driver.AnonymisedAt,
+	}
+}
+
+func driversToApi(drivers []Driver) []models.Driver {
+	apiDrivers := make([]models.Driver, len(drivers))
+	for i, driver := range drivers {
+		apiDrivers[i] = driver.toApi()
+	}
+	return apiDrivers
+}
+
+func driverFromApi(driver models.Driver) Driver {
+	return Driver{
+		Id:               driver.Id,
+		FirstName:        driver.FirstName,
+		LastName:         driver.LastName,
+		MobileNo:         pii.EncryptedInt(driver.MobileNo),
+		Email:            pii.EncryptedString(driver.Email),
+		CarLicenseNo:     driver.CarLicenseNo,
+		ActiveVehicleId:  driver.ActiveVehicleId,
+		Online:           driver.Online,
+		ActiveTripId:     driver.ActiveTripId,
+		OnboardingStatus: driver.OnboardingStatus,
+		OnboardingNote:   driver.OnboardingNote,
+		Version:          driver.Version,
+	}
+}
+
+func (vehicle Vehicle) toApi() models.Vehicle {
+	return models.Vehicle{
+		Id:           vehicle.Id,
+		LicensePlate: vehicle.LicensePlate,
+		Make:         vehicle.Make,
+		Model:        vehicle.Model,
+		Colour:       vehicle.Colour,
+		Seats:        vehicle.Seats,
+		VehicleClass: vehicle.VehicleClass,
+		Version:      vehicle.Version,
+	}
+}
+
+func vehiclesToApi(vehicles []Vehicle) []models.Vehicle {
+	apiVehicles := make([]models.Vehicle, len(vehicles))
+	for i, vehicle := range vehicles {
+		apiVehicles[i] = vehicle.toApi()
+	}
+	return apiVehicles
+}
+
+func vehicleFromApi(vehicle models.Vehicle) Vehicle {
+	return Vehicle{
+		Id:           vehicle.Id,
+		LicensePlate: vehicle.LicensePlate,
+		Make:         vehicle.Make,
+		Model:        vehicle.Model,
+		Colour:       vehicle.Colour,
+		Seats:        vehicle.Seats,
+		VehicleClass: vehicle.VehicleClass,
+		Version:      vehicle.Version,
+	}
+}
+
+func (shift Shift) toApi() models.Shift {
+	return models.Shift{
+		Id:        shift.Id,
+		DriverId:  shift.DriverId,
+		VehicleId: shift.VehicleId,
+		StartedAt: shift.StartedAt,
+		EndedAt:   shift.EndedAt,
+		EndReason: shift.EndReason,
+	}
+}
+
+func shiftsToApi(shifts []Shift) []models.Shift {
+	apiShifts := make([]models.Shift, len(shifts))
+	for i, shift := range shifts {
+		apiShifts[i] = shift.toApi()
+	}
+	return apiShifts
+}
+
+func (suspension Suspension) toApi() models.Suspension {
+	return models.Suspension{
+		Id:             suspension.Id,
+		DriverId:       suspension.DriverId,
+		ReasonCode:     suspension.ReasonCode,
+		Note:           suspension.Note,
+		EffectiveFrom:  suspension.EffectiveFrom,
+		EffectiveUntil: suspension.EffectiveUntil,
+		Applied:        suspension.Applied,
+		ReinstatedAt:   suspension.ReinstatedAt,
+	}
+}
+
+func suspensionFromApi(suspension models.Suspension) Suspension {
+	return Suspension{
+		Id:             suspension.Id,
+		DriverId:       suspension.DriverId,
+		ReasonCode:     suspension.ReasonCode,
+		Note:           suspension.Note,
+		EffectiveFrom:  suspension.EffectiveFrom,
+		EffectiveUntil: suspension.EffectiveUntil,
+	}
+}
+
+func (document Document) toApi() models.Document {
+	return models.Document{
+		Id:           document.Id,
+		DriverId:     document.DriverId,
+		DocumentType: document.DocumentType,
+		ContentType:  document.ContentType,
+		Size:         document.Size,
+		UploadedAt:   document.UploadedAt,
+	}
+}
+
+func documentsToApi(documents []Document) []models.Document {
+	apiDocuments := make([]models.Document, len(documents))
+	for i, document := range documents {
+		apiDocuments[i] = document.toApi()
+	}
+	return apiDocuments
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func deletedAtTime(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &deletedAt.Time
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//BlobStore keeps uploaded files, such as driver documents, outside of the database
+type BlobStore interface {
+	Put(key string, data io.Reader) error
+	Get(key string) (io.ReadCloser, error)
+	Delete(key string) error
+}
+
+var blobStore BlobStore
+
+func initBlobStore() {
+	switch config.BlobStore {
+	case "local":
+		blobStore = localBlobStore{dir: config.BlobDir}
+	default:
+		log.Fatal("Unknown BLOB_STORE: " + config.BlobStore)
+	}
+}
+
+/////////////////////////
+//                     //
+//   Local Blob Store  //
+//                     //
+/////////////////////////
+
+//localBlobStore keeps each blob as a file under dir, keys may contain "/" to make sub-directories
+type localBlobStore struct {
+	dir string
+}
+
+func (store localBlobStore) Put(key string, data io.Reader) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	//write to a temporary file first so a failed upload never replaces a good file
+	tempFile, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = io.Copy(tempFile, data)
+	closeErr := tempFile.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
+
+func (store localBlobStore) Get(key string) (io.ReadCloser, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (store localBlobStore) Delete(key string) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+//Keys must stay inside dir
+func (store localBlobStore) path(key string) (string, error) {
+	if key == "" || strings.Contains(key, "..") {
+		return "", errors.New("invalid blob key")
+	}
+	return filepath.Join(store.dir, filepath.Clean("/"+key)), nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"shared/settings"
+)
+
+//The settings every microservice has are in settings.Service, see shared/settings for how they're loaded
+type Config struct {
+	settings.Service
+	BlobStore       string
+	BlobDir         string
+	TripGrpcAddress string
+	PiiKeys         string
+	PiiIndexKey     string
+}
+
+var config Config
+
+const defaultConfigFile = "../.env"
+
+func defaultConfig() Config {
+	return Config{
+		Service:         settings.DefaultService(5001),
+		BlobStore:       "local",
+		BlobDir:         "blobs",
+		TripGrpcAddress: "localhost:6002",
+	}
+}
+
+func configSettings() []*settings.Setting {
+	return append(config.Service.Settings("DRIVER_PORT", "drivers"),
+		&settings.Setting{Name: "BLOB_STORE", Value: &config.BlobStore, Usage: "where uploaded documents are kept, only \"local\" for now"},
+		&settings.Setting{Name: "BLOB_DIR", Value: &config.BlobDir, Usage: "directory of the local blob store"},
+		&settings.Setting{Name: "TRIP_GRPC_ADDRESS", Value: &config.TripGrpcAddress, Usage: "host:port of the trip microservice's gRPC server"},
+		&settings.Setting{Name: "PII_KEYS", Value: &config.PiiKeys, Usage: "personal data encryption keys as id:base64key,...", Redact: settings.RedactSecret},
+		&settings.Setting{Name: "PII_INDEX_KEY", Value: &config.PiiIndexKey, Usage: "base64 key for the email lookup index", Redact: settings.RedactSecret},
+	)
+}
+
+//Returns a message for each invalid setting
+func validateConfig() []string {
+	problems := config.Service.Validate("DRIVER_PORT")
+	if config.BlobStore != "local" {
+		problems = append(problems, "BLOB_STORE must be \"local\"")
+	}
+	if config.BlobStore == "local" && config.BlobDir == "" {
+		problems = append(problems, "BLOB_DIR is required for the local blob store")
+	}
+	if config.TripGrpcAddress == "" {
+		problems = append(problems, "TRIP_GRPC_ADDRESS is required")
+	}
+	if config.PiiKeys == "" {
+		problems = append(problems, "PII_KEYS is required, see README.md for how to generate it")
+	}
+	if config.PiiIndexKey == "" {
+		problems = append(problems, "PII_INDEX_KEY is required, see README.md for how to generate it")
+	}
+	return problems
+}
+
+/*
+This function loads the config into the global "config" and returns the arguments left after the flags.
+It stops the program if the config is invalid, or after printing it for --print-config
+*/
+func loadConfig() []string {
+	config = defaultConfig()
+	return settings.Load(defaultConfigFile, configSettings(), validateConfig)
+}
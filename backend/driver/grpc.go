@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+
+	"shared/proto/driverpb"
+	"shared/proto/pbtime"
+	"shared/proto/trippb"
+	"shared/service"
+)
+
+//The gRPC servers call the same service functions as the HTTP functions, see shared/proto/driver.proto.
+//Errors are turned into gRPC statuses by shared/rpc
+type driverServer struct {
+	driverpb.UnimplementedDriverServiceServer
+}
+
+type vehicleServer struct {
+	driverpb.UnimplementedVehicleServiceServer
+}
+
+func (driverServer) ListDrivers(ctx context.Context, req *driverpb.ListDriversRequest) (*driverpb.Drivers, error) {
+	search := driverSearch{
+		Available: req.Available,
+		RideClass: req.GetRideClass(),
+		PartySize: int(req.GetPartySize()),
+		Email:     req.GetEmail(),
+	}
+
+	drivers, err := listDrivers(ctx, search, req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromDrivers(driversToApi(drivers)), nil
+}
+
+func (driverServer) GetDriver(ctx context.Context, req *driverpb.Id) (*driverpb.Driver, error) {
+	driver, err := findDriver(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromDriver(driver.toApi()), nil
+}
+
+func (driverServer) CreateDriver(ctx context.Context, req *driverpb.Driver) (*driverpb.Driver, error) {
+	driver, err := createDriver(ctx, driverFromApi(req.ToModel()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromDriver(driver.toApi()), nil
+}
+
+func (driverServer) UpdateDriver(ctx context.Context, req *driverpb.Driver) (*driverpb.Driver, error) {
+	driver, err := updateDriver(ctx, int(req.GetId()), driverFromApi(req.ToModel()), service.IfMatchVersion(int(req.GetVersion())))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromDriver(driver.toApi()), nil
+}
+
+func (driverServer) DeleteDriver(ctx context.Context, req *driverpb.VersionedId) (*driverpb.Result, error) {
+	message, err := deleteDriver(ctx, int(req.GetId()), service.IfMatchVersion(int(req.GetVersion())))
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.Result{Message: message}, nil
+}
+
+func (driverServer) RestoreDriver(ctx context.Context, req *driverpb.Id) (*driverpb.Result, error) {
+	message, err := restoreDriver(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.Result{Message: message}, nil
+}
+
+func (driverServer) ExportDriver(ctx context.Context, req *driverpb.Id) (*driverpb.DriverExport, error) {
+	export, err := exportDriver(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	suspensions := make([]*driverpb.Suspension, len(export.Suspensions))
+	for i, suspension := range export.Suspensions {
+		suspensions[i] = driverpb.FromSuspension(suspension.toApi())
+	}
+
+	return &driverpb.DriverExport{
+		Driver:      driverpb.FromDriver(export.Driver.toApi()),
+		Vehicles:    driverpb.FromVehicles(vehiclesToApi(export.Vehicles)).GetVehicles(),
+		Documents:   driverpb.FromDocuments(documentsToApi(export.Documents)).GetDocuments(),
+		Shifts:      driverpb.FromShifts(shiftsToApi(export.Shifts)).GetShifts(),
+		Suspensions: suspensions,
+		AuditLog:    auditLogToPb(export.AuditLog),
+		Trips:       trippb.FromTrips(export.Trips).GetTrips(),
+		ExportedAt:  pbtime.RequiredTimestamp(export.ExportedAt),
+	}, nil
+}
+
+func (driverServer) AnonymiseDriver(ctx context.Context, req *driverpb.Id) (*driverpb.Result, error) {
+	message, err := anonymiseDriver(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.Result{Message: message}, nil
+}
+
+func (driverServer) ListDriverVehicles(ctx context.Context, req *driverpb.Id) (*driverpb.Vehicles, error) {
+	vehicles, err := listDriverVehicles(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromVehicles(vehiclesToApi(vehicles)), nil
+}
+
+func (driverServer) AddDriverVehicle(ctx context.Context, req *driverpb.DriverVehicle) (*driverpb.Vehicle, error) {
+	vehicle, err := addDriverVehicle(ctx, int(req.GetDriverId()), int(req.GetVehicleId()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromVehicle(vehicle.toApi()), nil
+}
+
+func (driverServer) RemoveDriverVehicle(ctx context.Context, req *driverpb.DriverVehicle) (*driverpb.Result, error) {
+	message, err := removeDriverVehicle(ctx, int(req.GetDriverId()), int(req.GetVehicleId()))
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.Result{Message: message}, nil
+}
+
+func (driverServer) SetActiveVehicle(ctx context.Context, req *driverpb.DriverVehicle) (*driverpb.Vehicle, error) {
+	vehicle, err := setActiveVehicle(ctx, int(req.GetDriverId()), int(req.GetVehicleId()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromVehicle(vehicle.toApi()), nil
+}
+
+func (driverServer) GoOnline(ctx context.Context, req *driverpb.DriverVehicle) (*driverpb.Shift, error) {
+	shift, err := goOnline(ctx, int(req.GetDriverId()), int(req.GetVehicleId()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromShift(shift.toApi()), nil
+}
+
+func (driverServer) GoOffline(ctx context.Context, req *driverpb.Id) (*driverpb.Shift, error) {
+	shift, err := goOffline(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromShift(shift.toApi()), nil
+}
+
+func (driverServer) Heartbeat(ctx context.Context, req *driverpb.Id) (*driverpb.Result, error) {
+	message, err := driverHeartbeat(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.Result{Message: message}, nil
+}
+
+func (driverServer) SetActiveTrip(ctx context.Context, req *driverpb.ActiveTripRequest) (*driverpb.Driver, error) {
+	driver, err := setActiveTrip(ctx, int(req.GetDriverId()), int(req.GetTripId()), int(req.GetReleasedTripId()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromDriver(driver.toApi()), nil
+}
+
+func (driverServer) ListDriverShifts(ctx context.Context, req *driverpb.Id) (*driverpb.Shifts, error) {
+	shifts := listDriverShifts(ctx, int(req.GetId()))
+	return driverpb.FromShifts(shiftsToApi(shifts)), nil
+}
+
+func (driverServer) GetShiftHours(ctx context.Context, req *driverpb.ShiftHoursRequest) (*driverpb.ShiftHours, error) {
+	hours, err := getShiftHours(ctx, req.GetFrom(), req.GetTo(), int(req.GetDriverId()))
+	if err != nil {
+		return nil, err
+	}
+
+	pbHours := make([]*driverpb.DriverHours, len(hours))
+	for i, day := range hours {
+		pbHours[i] = &driverpb.DriverHours{
+			DriverId: int64(day.DriverId),
+			Date:     day.Date,
+			Hours:    day.Hours,
+		}
+	}
+	return &driverpb.ShiftHours{Hours: pbHours}, nil
+}
+
+func (driverServer) ListDriverDocuments(ctx context.Context, req *driverpb.Id) (*driverpb.Documents, error) {
+	documents := listDriverDocuments(ctx, int(req.GetId()))
+	return driverpb.FromDocuments(documentsToApi(documents)), nil
+}
+
+func (driverServer) UploadDriverDocument(ctx context.Context, req *driverpb.DocumentUpload) (*driverpb.Document, error) {
+	document, err := uploadDriverDocument(ctx, int(req.GetDriverId()), req.GetDocumentType(), req.GetContentType(), bytes.NewReader(req.GetContent()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromDocument(document.toApi()), nil
+}
+
+func (driverServer) DownloadDriverDocument(ctx context.Context, req *driverpb.DocumentRequest) (*driverpb.DocumentContent, error) {
+	document, blob, err := downloadDriverDocument(ctx, int(req.GetDriverId()), req.GetDocumentType())
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+
+	content, err := ioutil.ReadAll(blob)
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.DocumentContent{ContentType: document.ContentType, Content: content}, nil
+}
+
+func (driverServer) ApproveDriver(ctx context.Context, req *driverpb.Id) (*driverpb.Result, error) {
+	message, err := approveDriver(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.Result{Message: message}, nil
+}
+
+func (driverServer) RejectDriver(ctx context.Context, req *driverpb.RejectRequest) (*driverpb.Result, error) {
+	message, err := rejectDriver(ctx, int(req.GetId()), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.Result{Message: message}, nil
+}
+
+func (driverServer) SuspendDriver(ctx context.Context, req *driverpb.Suspension) (*driverpb.Suspension, error) {
+	suspension, err := suspendDriver(ctx, int(req.GetDriverId()), suspensionFromApi(req.ToModel()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromSuspension(suspension.toApi()), nil
+}
+
+func (driverServer) ReinstateDriver(ctx context.Context, req *driverpb.ReinstateRequest) (*driverpb.Result, error) {
+	message, err := reinstateDriver(ctx, int(req.GetId()), req.GetNote())
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.Result{Message: message}, nil
+}
+
+func (driverServer) GetDriverSuspension(ctx context.Context, req *driverpb.Id) (*driverpb.Suspension, error) {
+	suspension, err := getDriverSuspension(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromSuspension(suspension.toApi()), nil
+}
+
+func (driverServer) GetDriverAuditLog(ctx context.Context, req *driverpb.Id) (*driverpb.AuditLog, error) {
+	auditLog, err := getDriverAuditLog(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.AuditLog{Entries: auditLogToPb(auditLog)}, nil
+}
+
+func (vehicleServer) ListVehicles(ctx context.Context, req *driverpb.ListVehiclesRequest) (*driverpb.Vehicles, error) {
+	vehicles := listVehicles(ctx, req.GetLicensePlate())
+	return driverpb.FromVehicles(vehiclesToApi(vehicles)), nil
+}
+
+func (vehicleServer) GetVehicle(ctx context.Context, req *driverpb.Id) (*driverpb.Vehicle, error) {
+	vehicle, err := findVehicle(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromVehicle(vehicle.toApi()), nil
+}
+
+func (vehicleServer) CreateVehicle(ctx context.Context, req *driverpb.Vehicle) (*driverpb.Vehicle, error) {
+	vehicle, err := createVehicle(ctx, vehicleFromApi(req.ToModel()))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromVehicle(vehicle.toApi()), nil
+}
+
+func (vehicleServer) UpdateVehicle(ctx context.Context, req *driverpb.Vehicle) (*driverpb.Vehicle, error) {
+	vehicle, err := updateVehicle(ctx, int(req.GetId()), vehicleFromApi(req.ToModel()), service.IfMatchVersion(int(req.GetVersion())))
+	if err != nil {
+		return nil, err
+	}
+	return driverpb.FromVehicle(vehicle.toApi()), nil
+}
+
+func (vehicleServer) DeleteVehicle(ctx context.Context, req *driverpb.VersionedId) (*driverpb.Result, error) {
+	message, err := deleteVehicle(ctx, int(req.GetId()), service.IfMatchVersion(int(req.GetVersion())))
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.Result{Message: message}, nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func auditLogToPb(auditLog []DriverAuditLog) []*driverpb.AuditEntry {
+	entries := make([]*driverpb.AuditEntry, len(auditLog))
+	for i, entry := range auditLog {
+		entries[i] = &driverpb.AuditEntry{
+			Id:          int64(entry.Id),
+			DriverId:    int64(entry.DriverId),
+			Action:      entry.Action,
+			ReasonCode:  entry.ReasonCode,
+			Note:        entry.Note,
+			EffectiveAt: pbtime.RequiredTimestamp(entry.EffectiveAt),
+			RecordedAt:  pbtime.RequiredTimestamp(entry.RecordedAt),
+		}
+	}
+	return entries
+}
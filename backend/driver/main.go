@@ -1,214 +1,400 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
-	"os"
-	"reflect"
 	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
-	"github.com/joho/godotenv"
-	"gorm.io/driver/mysql"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	"gorm.io/gorm"
+
+	"shared/database"
+	"shared/httputil"
+	"shared/logging"
+	"shared/metrics"
+	"shared/models"
+	"shared/openapi"
+	"shared/pii"
+	"shared/proto/driverpb"
+	"shared/rpc"
+	"shared/server"
+	"shared/service"
+	"shared/tracing"
 )
 
 type Driver struct {
-	Id           int `gorm:"primaryKey"`
-	FirstName    string
-	LastName     string
-	MobileNo     int
-	Email        string
-	CarLicenseNo string
-	Available    bool
+	Id              int `gorm:"primaryKey"`
+	FirstName       string
+	LastName        string
+	MobileNo        pii.EncryptedInt
+	Email           pii.EncryptedString
+	EmailIndex      string `gorm:"uniqueIndex:idx_drivers_email_unique;size:64" json:"-"` //blind index for looking up Email
+	CarLicenseNo    string //plate of the active vehicle, kept for older clients
+	Available       bool   //approved, online and not on a trip, never set directly
+	ActiveVehicleId int
+	Online          bool
+	ActiveTripId    int
+	LastActiveAt    time.Time
+
+	OnboardingStatus string //"pending", "under_review", "approved" or "suspended"
+	OnboardingNote   string //why the driver was sent back to pending
+
+	Vehicles []Vehicle `gorm:"many2many:driver_vehicles;" json:"-"`
+
+	Version      int            `gorm:"not null;default:1"` //bumped on every change, sent as the ETag
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+	AnonymisedAt *time.Time     //personal data was scrubbed on request
 }
 
+const serviceName = "driver"
+
 var db *gorm.DB
 
 func main() {
-	loadEnv()
-	initDb()
-	migrateDb()
-	initRouter()
+	args := loadConfig()
+	logging.Init(serviceName)
+	tracing.Init(serviceName, config.Service)
+	pii.Init(config.PiiKeys, config.PiiIndexKey)
+	db = database.Open(config.Dsn)
+
+	if len(args) > 0 && args[0] == "migrate" {
+		database.RunMigrateCommand(db, serviceName, args[1:])
+		return
+	}
+
+	latestSchemaVersion := database.CheckSchemaVersion(db, serviceName)
+	pii.Reencrypt(db, &Driver{})
+	initBlobStore()
+	initTripClient()
+	startInactivityMonitor()
+	startSuspensionMonitor()
+	startPurgeMonitor()
+	initRouter(latestSchemaVersion)
 }
 
-func loadEnv() {
-	err := godotenv.Load("../.env")
-	if err != nil {
-		log.Fatal("Error loading .env file")
-	}
+func initRouter(latestSchemaVersion int) {
+	router := mux.NewRouter()
+
+	router.HandleFunc("/healthz", server.GetHealth).Methods("GET")
+	router.HandleFunc("/readyz", server.GetReadiness(db, serviceName, latestSchemaVersion)).Methods("GET")
+	router.Use(otelmux.Middleware(serviceName))
+	router.Use(logging.Middleware)
+	router.Use(httputil.AdminMiddleware(config.AdminPassword))
+	metrics.Init(router, db, driversOnlineGauge, driversAvailableGauge, driverSearches)
+	openapi.Init(router, "openapi.yaml")
+
+	router.HandleFunc("/drivers", httpGetDrivers).Methods("GET")
+	router.HandleFunc("/drivers/{id}", httpGetDriverById).Methods("GET")
+	router.HandleFunc("/drivers", httpCreateDriver).Methods("POST")
+	router.HandleFunc("/drivers/{id}", httpUpdateDriver).Methods("PUT")
+	router.HandleFunc("/drivers/{id}", httpDeleteDriver).Methods("DELETE")
+	router.HandleFunc("/drivers/{id}/restore", httpRestoreDriver).Methods("POST")
+	router.HandleFunc("/drivers/{id}/export", httpExportDriver).Methods("GET")
+	router.HandleFunc("/drivers/{id}/anonymise", httpAnonymiseDriver).Methods("POST")
+	router.HandleFunc("/drivers/{id}/vehicles", httpGetDriverVehicles).Methods("GET")
+	router.HandleFunc("/drivers/{id}/vehicles/{vehicleId}", httpAddDriverVehicle).Methods("PUT")
+	router.HandleFunc("/drivers/{id}/vehicles/{vehicleId}", httpRemoveDriverVehicle).Methods("DELETE")
+	router.HandleFunc("/drivers/{id}/activeVehicle", httpSetActiveVehicle).Methods("PUT")
+	router.HandleFunc("/drivers/{id}/online", httpGoOnline).Methods("POST")
+	router.HandleFunc("/drivers/{id}/offline", httpGoOffline).Methods("POST")
+	router.HandleFunc("/drivers/{id}/heartbeat", httpDriverHeartbeat).Methods("POST")
+	router.HandleFunc("/drivers/{id}/activeTrip", httpSetActiveTrip).Methods("PUT")
+	router.HandleFunc("/drivers/{id}/shifts", httpGetDriverShifts).Methods("GET")
+	router.HandleFunc("/shifts/hours", httpGetShiftHours).Methods("GET")
+	router.HandleFunc("/drivers/{id}/documents", httpGetDriverDocuments).Methods("GET")
+	router.HandleFunc("/drivers/{id}/documents/{documentType}", httpUploadDriverDocument).Methods("PUT")
+	router.HandleFunc("/drivers/{id}/documents/{documentType}", httpDownloadDriverDocument).Methods("GET")
+	router.HandleFunc("/drivers/{id}/approve", httpApproveDriver).Methods("POST")
+	router.HandleFunc("/drivers/{id}/reject", httpRejectDriver).Methods("POST")
+	router.HandleFunc("/drivers/{id}/suspend", httpSuspendDriver).Methods("POST")
+	router.HandleFunc("/drivers/{id}/reinstate", httpReinstateDriver).Methods("POST")
+	router.HandleFunc("/drivers/{id}/suspension", httpGetDriverSuspension).Methods("GET")
+	router.HandleFunc("/drivers/{id}/audit", httpGetDriverAuditLog).Methods("GET")
+
+	router.HandleFunc("/vehicles", httpGetVehicles).Methods("GET")
+	router.HandleFunc("/vehicles/{id}", httpGetVehicleById).Methods("GET")
+	router.HandleFunc("/vehicles", httpCreateVehicle).Methods("POST")
+	router.HandleFunc("/vehicles/{id}", httpUpdateVehicle).Methods("PUT")
+	router.HandleFunc("/vehicles/{id}", httpDeleteVehicle).Methods("DELETE")
+
+	grpcServer := rpc.NewServer(config.AdminPassword)
+	driverpb.RegisterDriverServiceServer(grpcServer, driverServer{})
+	driverpb.RegisterVehicleServiceServer(grpcServer, vehicleServer{})
+
+	server.Start("Driver", router, grpcServer, config.Service, db)
 }
 
-func initDb() {
-	dsn := os.Getenv("DSN")
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
 
-	var err error
+func httpGetDrivers(w http.ResponseWriter, r *http.Request) {
+	urlParams := r.URL.Query()
+	includeDeleted, _ := strconv.ParseBool(urlParams.Get("includeDeleted"))
 
-	//set global var "db"
-	db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	search := driverSearch{
+		Email:     urlParams.Get("email"),
+		RideClass: urlParams.Get("rideClass"),
+	}
+	if queryAvailable, ok := urlParams["available"]; ok {
+		available, _ := strconv.ParseBool(queryAvailable[0])
+		search.Available = &available
+	}
+	search.PartySize, _ = strconv.Atoi(urlParams.Get("partySize"))
+
+	drivers, err := listDrivers(r.Context(), search, includeDeleted)
 	if err != nil {
-		panic("Failed to connect to database with DSN: " + dsn)
+		httputil.RespondWithError(w, err)
+		return
 	}
+
+	httputil.RespondWith(w, http.StatusOK, driversToApi(drivers))
 }
 
-func migrateDb() {
-	err := db.AutoMigrate(&Driver{})
+func httpGetDriverById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	driver, err := findDriver(r.Context(), id)
 	if err != nil {
-		panic("DB Migration failed with error: " + err.Error())
+		httputil.RespondWithError(w, err)
+		return
 	}
-}
 
-func initRouter() {
-	router := mux.NewRouter()
+	httputil.SetETag(w, driver.Version)
+	httputil.RespondWith(w, http.StatusOK, driver.toApi())
+}
 
-	router.HandleFunc("/drivers", getDrivers).Methods("GET")
-	router.HandleFunc("/drivers/{id}", getDriverById).Methods("GET")
-	router.HandleFunc("/drivers", createDriver).Methods("POST")
-	router.HandleFunc("/drivers/{id}", updateDriver).Methods("PUT")
-	router.HandleFunc("/drivers/{id}", deleteDriver).Methods("DELETE")
+func httpCreateDriver(w http.ResponseWriter, r *http.Request) {
+	var body models.Driver
 
-	portNo := os.Getenv("DRIVER_PORT")
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
 
-	fmt.Printf("Driver Microservice running on port %s...\n", portNo)
-	err := http.ListenAndServe(":"+portNo, router)
+	driver, err := createDriver(r.Context(), driverFromApi(body))
 	if err != nil {
-		panic("InitRouter failed with error: " + err.Error())
+		httputil.RespondWithError(w, err)
+		return
 	}
+
+	httputil.SetETag(w, driver.Version)
+	httputil.RespondWith(w, http.StatusCreated, driver.toApi())
 }
 
-/////////////////////////
-//                     //
-//    HTTP Functions   //
-//                     //
-/////////////////////////
+func httpUpdateDriver(w http.ResponseWriter, r *http.Request) {
+	var body models.Driver
 
-func getDrivers(w http.ResponseWriter, r *http.Request) {
-	var drivers []Driver
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
 
-	urlParams := r.URL.Query()
-	if queryAvailable, ok := urlParams["available"]; ok {
-		stringAvailable := queryAvailable[0]
-		available, _ := strconv.ParseBool(stringAvailable)
-		db.Where("available = ?", available).Find(&drivers)
-	} else if queryEmail, ok := urlParams["email"]; ok {
-		email := queryEmail[0]
-		db.Where("email = ?", email).Find(&drivers)
-	} else {
-		db.Find(&drivers)
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	driver, err := updateDriver(r.Context(), id, driverFromApi(body), httputil.IfMatch(r))
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
 	}
 
-	httpRespondWith(w, http.StatusOK, drivers)
+	httputil.SetETag(w, driver.Version)
+	httputil.RespondWith(w, http.StatusAccepted, driver.toApi())
 }
 
-func getDriverById(w http.ResponseWriter, r *http.Request) {
+func httpDeleteDriver(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
-	id := params["id"]
+	id, _ := strconv.Atoi(params["id"])
 
-	var driver Driver
-	err := db.Where("id = ?", id).First(&driver).Error
+	message, err := deleteDriver(r.Context(), id, httputil.IfMatch(r))
 	if err != nil {
-		httpRespondWith(w, http.StatusNotFound, "User doesn't exist")
+		httputil.RespondWithError(w, err)
 		return
 	}
 
-	httpRespondWith(w, http.StatusOK, driver)
+	httputil.RespondWith(w, http.StatusAccepted, message)
 }
 
-func createDriver(w http.ResponseWriter, r *http.Request) {
-	var driver Driver
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
 
-	decodeErr := json.NewDecoder(r.Body).Decode(&driver)
-	if decodeErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
-		return
+/*
+Drivers to list, by whether they are available or by email, all of them if neither is given.
+RideClass and PartySize narrow down searches by Available to drivers whose active vehicle can take the ride
+*/
+type driverSearch struct {
+	Available *bool
+	RideClass string
+	PartySize int
+	Email     string
+}
+
+func listDrivers(ctx context.Context, search driverSearch, includeDeleted bool) ([]Driver, error) {
+	var drivers []Driver
+
+	query, err := database.ListQuery(ctx, db, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	if search.Available != nil {
+		query = query.Where("available = ?", *search.Available)
+
+		//only match drivers whose active vehicle can take the ride
+		rideClass := "any"
+		if search.RideClass != "" || search.PartySize != 0 {
+			query = query.Joins("JOIN vehicles ON vehicles.id = drivers.active_vehicle_id")
+		}
+		if search.RideClass != "" {
+			vehicleClasses, ok := eligibleVehicleClasses[search.RideClass]
+			if !ok {
+				return nil, service.NewError(http.StatusBadRequest, "Invalid rideClass")
+			}
+			query = query.Where("vehicles.vehicle_class IN ?", vehicleClasses)
+			rideClass = search.RideClass
+		}
+		if search.PartySize != 0 {
+			query = query.Where("vehicles.seats >= ?", search.PartySize)
+		}
+
+		query.Find(&drivers)
+		if *search.Available {
+			countDriverSearch(rideClass, len(drivers))
+		}
+	} else if search.Email != "" {
+		query.Where("email_index = ?", pii.EmailIndex(search.Email)).Find(&drivers)
+	} else {
+		query.Find(&drivers)
 	}
+	return drivers, nil
+}
+
+func createDriver(ctx context.Context, driver Driver) (Driver, error) {
+	db := db.WithContext(ctx)
 
 	//validate empty fields
-	if isFieldMissing(w, driver.FirstName, "FirstName") ||
-		isFieldMissing(w, driver.LastName, "LastName") ||
-		isFieldMissing(w, driver.MobileNo, "MobileNo") ||
-		isFieldMissing(w, driver.Email, "Email") ||
-		isFieldMissing(w, driver.CarLicenseNo, "CarLicenseNo") {
-		return
+	err := service.FirstError(
+		service.RequireField(driver.FirstName, "FirstName"),
+		service.RequireField(driver.LastName, "LastName"),
+		service.RequireField(driver.MobileNo, "MobileNo"),
+		service.RequireField(driver.Email, "Email"),
+	)
+	if err != nil {
+		return driver, err
 	}
 
-	//validate email exist
-	emailExist := existInDb("email", driver.Email)
+	//validate email exist, emails of deleted drivers stay taken until they are purged
+	emailExist := database.ExistInDb(db, &Driver{}, "email_index", pii.EmailIndex(string(driver.Email)))
 	if emailExist {
-		httpRespondWith(w, http.StatusConflict, "Email already in-use.")
-		return
+		return driver, service.NewError(http.StatusConflict, "Email already in-use.")
 	}
 
-	//Disallow manual setting of Id
+	//Disallow manual setting of Id, Version and vehicle, vehicles are registered through /vehicles
 	driver.Id = 0
+	driver.Version = 1
+	driver.EmailIndex = pii.EmailIndex(string(driver.Email))
+	driver.CarLicenseNo = ""
+	driver.ActiveVehicleId = 0
 
-	//Drivers should be available on creation
-	driver.Available = true
+	//Drivers can't drive until their documents are approved
+	driver.OnboardingStatus = "pending"
+	driver.OnboardingNote = ""
+
+	//Drivers are offline until they start a shift
+	driver.Online = false
+	driver.ActiveTripId = 0
+	driver.Available = false
+	driver.LastActiveAt = time.Now()
 
 	dbErr := db.Create(&driver).Error
+	if database.IsDuplicateKeyError(dbErr) {
+		return driver, service.NewError(http.StatusConflict, "Email already in-use.")
+	}
 	if dbErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid Data")
-		return
+		return driver, service.NewError(http.StatusBadRequest, "Invalid Data")
 	}
-
-	httpRespondWith(w, http.StatusCreated, driver)
+	return driver, nil
 }
 
-func updateDriver(w http.ResponseWriter, r *http.Request) {
-	var driver Driver
+func updateDriver(ctx context.Context, id int, driver Driver, ifMatch service.IfMatch) (Driver, error) {
+	db := db.WithContext(ctx)
 
-	decodeErr := json.NewDecoder(r.Body).Decode(&driver)
-	if decodeErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
-		return
+	oldDriver, err := findDriver(ctx, id)
+	if err != nil {
+		return oldDriver, err
+	}
+	err = ifMatch.Check(oldDriver.Version)
+	if err != nil {
+		return oldDriver, err
 	}
-
-	params := mux.Vars(r)
-	id := params["id"]
 
 	//use map syntax for gorm so that it can update zero values
-	dbErr := db.Model(&Driver{}).Where("id = ?", id).Updates(map[string]interface{}{
-		"FirstName":    driver.FirstName,
-		"LastName":     driver.LastName,
-		"MobileNo":     driver.MobileNo,
-		"Email":        driver.Email,
-		"CarLicenseNo": driver.CarLicenseNo,
-		"Available":    driver.Available,
+	//CarLicenseNo follows the active vehicle and Available follows the shift so they aren't updated here
+	result := db.Model(&Driver{}).Where("id = ? AND version = ?", oldDriver.Id, oldDriver.Version).Updates(map[string]interface{}{
+		"FirstName":  driver.FirstName,
+		"LastName":   driver.LastName,
+		"MobileNo":   driver.MobileNo,
+		"Email":      driver.Email,
+		"EmailIndex": pii.EmailIndex(string(driver.Email)),
 	})
-	if dbErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid Data")
-		return
+	if database.IsDuplicateKeyError(result.Error) {
+		return oldDriver, service.NewError(http.StatusConflict, "Email already in-use.")
+	}
+	if result.Error != nil {
+		return oldDriver, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	if result.RowsAffected == 0 {
+		return oldDriver, service.VersionChanged()
 	}
 
 	var newDriver Driver
-	db.Where("id = ?", id).First(&newDriver)
-
-	httpRespondWith(w, http.StatusAccepted, newDriver)
+	db.Where("id = ?", oldDriver.Id).First(&newDriver)
+	return newDriver, nil
 }
 
-func deleteDriver(w http.ResponseWriter, r *http.Request) {
-	//check admin password
-	if !hasValidAdminPass(r) {
-		httpRespondWith(w, http.StatusForbidden, "Unauthorized User")
-		return
+func deleteDriver(ctx context.Context, id int, ifMatch service.IfMatch) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
 	}
 
-	params := mux.Vars(r)
-	id := params["id"]
-
-	//check user exist
-	idExist := existInDb("id", id)
-	if !idExist {
-		httpRespondWith(w, http.StatusNotFound, "User doesn't exist")
-		return
+	driver, err := findDriver(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if driver.ActiveTripId != 0 {
+		return "", service.NewError(http.StatusConflict, "Driver cannot be deleted during a trip")
+	}
+	err = ifMatch.Check(driver.Version)
+	if err != nil {
+		return "", err
 	}
 
-	//delete from driver where id = id
-	db.Delete(&Driver{}, id)
+	//soft delete so that the driver can be restored until purged
+	result := db.Where("version = ?", driver.Version).Delete(&driver)
+	if result.RowsAffected == 0 {
+		return "", service.VersionChanged()
+	}
+	if driver.Online {
+		endShift(driver, "offline")
+	}
+	recordDriverAudit(driver.Id, "deleted", "", "", time.Now())
 
-	httpRespondWith(w, http.StatusAccepted, fmt.Sprintf("User of ID %s successfully deleted", id))
+	return fmt.Sprintf("User of ID %d successfully deleted", driver.Id), nil
 }
 
 /////////////////////////
@@ -217,47 +403,13 @@ func deleteDriver(w http.ResponseWriter, r *http.Request) {
 //                     //
 /////////////////////////
 
-func httpRespondWith(w http.ResponseWriter, statusCode int, data interface{}) {
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(data)
-}
+func findDriver(ctx context.Context, id int) (Driver, error) {
+	db := db.WithContext(ctx)
 
-/*
-This function checks whether a data has a zero value.
-If it does, it will return true and write a http response
-*/
-func isFieldMissing(w http.ResponseWriter, data interface{}, fieldName string) bool {
-	if isZero(data) {
-		errorMsg := fmt.Sprintf("%s field is missing/incorrect.", fieldName)
-		httpRespondWith(w, http.StatusBadRequest, errorMsg)
-		return true
-	}
-	return false
-}
-
-func isZero(data interface{}) bool {
-	value := reflect.ValueOf(data)
-	return value.IsZero()
-}
-
-/*
-This function returns whether a row with the given value for the given fieldName exists
-*/
-func existInDb(fieldName string, value interface{}) bool {
-	var dbDriver Driver
-
-	//if email doens't exist, db.First returns a ErrRecordNotFound
-	err := db.Where(fieldName+" = ?", value).First(&dbDriver).Error
-	return err != gorm.ErrRecordNotFound
-}
-
-func hasValidAdminPass(r *http.Request) bool {
-	urlParams := r.URL.Query()
-	if queryPassword, ok := urlParams["adminPassword"]; ok {
-		password := os.Getenv("ADMIN_PASSWORD")
-		if queryPassword[0] == password {
-			return true
-		}
+	var driver Driver
+	err := db.Where("id = ?", id).First(&driver).Error
+	if err != nil {
+		return driver, service.NewError(http.StatusNotFound, "User doesn't exist")
 	}
-	return false
+	return driver, nil
 }
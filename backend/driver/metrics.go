@@ -0,0 +1,38 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//Counted when scraped, so that they are right whichever instance made the change
+var driversOnlineGauge = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+	Name: "hytchhyke_drivers_online",
+	Help: "Drivers that are online, including those on a trip",
+}, func() float64 {
+	var count int64
+	db.Model(&Driver{}).Where("online = ?", true).Count(&count)
+	return float64(count)
+})
+
+var driversAvailableGauge = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+	Name: "hytchhyke_drivers_available",
+	Help: "Drivers that can be booked",
+}, func() float64 {
+	var count int64
+	db.Model(&Driver{}).Where("available = ?", true).Count(&count)
+	return float64(count)
+})
+
+//A search that finds no one is a booking the console fails with "No available drivers"
+var driverSearches = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "hytchhyke_driver_searches_total",
+	Help: "Searches for available drivers by ride class and whether any were found",
+}, []string{"ride_class", "result"})
+
+func countDriverSearch(rideClass string, found int) {
+	result := "found"
+	if found == 0 {
+		result = "none"
+	}
+	driverSearches.WithLabelValues(rideClass, result).Inc()
+}
@@ -0,0 +1,3 @@
+ALTER TABLE drivers DROP COLUMN active_vehicle_id;
+DROP TABLE driver_vehicles;
+DROP TABLE vehicles;
@@ -0,0 +1,20 @@
+CREATE TABLE vehicles (
+    id bigint NOT NULL AUTO_INCREMENT,
+    license_plate varchar(16),
+    make longtext,
+    model longtext,
+    colour longtext,
+    seats bigint,
+    vehicle_class longtext,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE driver_vehicles (
+    driver_id bigint NOT NULL,
+    vehicle_id bigint NOT NULL,
+    PRIMARY KEY (driver_id, vehicle_id),
+    CONSTRAINT fk_driver_vehicles_driver FOREIGN KEY (driver_id) REFERENCES drivers (id),
+    CONSTRAINT fk_driver_vehicles_vehicle FOREIGN KEY (vehicle_id) REFERENCES vehicles (id)
+);
+
+ALTER TABLE drivers ADD COLUMN active_vehicle_id bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- The vehicles are kept, they can't be told apart from ones added since
+UPDATE drivers SET active_vehicle_id = 0;
@@ -0,0 +1,14 @@
+-- Drivers registered before vehicles existed only have a CarLicenseNo,
+-- each of them is given a vehicle with that plate as their active vehicle
+INSERT INTO vehicles (license_plate, make, model, colour, seats, vehicle_class)
+SELECT DISTINCT car_license_no, 'Unknown', 'Unknown', 'Unknown', 4, 'standard'
+FROM drivers
+WHERE car_license_no <> '' AND active_vehicle_id = 0;
+
+INSERT INTO driver_vehicles (driver_id, vehicle_id)
+SELECT drivers.id, vehicles.id
+FROM drivers JOIN vehicles ON vehicles.license_plate = drivers.car_license_no
+WHERE drivers.active_vehicle_id = 0;
+
+UPDATE drivers SET active_vehicle_id = (SELECT MIN(vehicles.id) FROM vehicles WHERE vehicles.license_plate = drivers.car_license_no)
+WHERE active_vehicle_id = 0 AND car_license_no <> '';
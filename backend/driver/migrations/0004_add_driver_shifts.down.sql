@@ -0,0 +1,4 @@
+DROP TABLE shifts;
+ALTER TABLE drivers DROP COLUMN last_active_at;
+ALTER TABLE drivers DROP COLUMN active_trip_id;
+ALTER TABLE drivers DROP COLUMN online;
@@ -0,0 +1,14 @@
+ALTER TABLE drivers ADD COLUMN online boolean NOT NULL DEFAULT FALSE;
+ALTER TABLE drivers ADD COLUMN active_trip_id bigint NOT NULL DEFAULT 0;
+ALTER TABLE drivers ADD COLUMN last_active_at datetime(3) NULL;
+
+CREATE TABLE shifts (
+    id bigint NOT NULL AUTO_INCREMENT,
+    driver_id bigint,
+    vehicle_id bigint,
+    started_at datetime(3) NULL,
+    ended_at datetime(3) NULL,
+    end_reason longtext,
+    PRIMARY KEY (id),
+    INDEX idx_shifts_driver_id (driver_id)
+);
@@ -0,0 +1 @@
+-- Availability was set directly before, so it is left as it is
@@ -0,0 +1,2 @@
+-- Availability used to be set directly, it is now derived from the driver's shift and trip
+UPDATE drivers SET available = (online AND active_trip_id = 0);
@@ -0,0 +1,3 @@
+DROP TABLE documents;
+ALTER TABLE drivers DROP COLUMN onboarding_note;
+ALTER TABLE drivers DROP COLUMN onboarding_status;
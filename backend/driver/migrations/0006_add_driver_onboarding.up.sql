@@ -0,0 +1,14 @@
+ALTER TABLE drivers ADD COLUMN onboarding_status longtext;
+ALTER TABLE drivers ADD COLUMN onboarding_note longtext;
+
+CREATE TABLE documents (
+    id bigint NOT NULL AUTO_INCREMENT,
+    driver_id bigint,
+    document_type longtext,
+    content_type longtext,
+    size bigint,
+    blob_key longtext,
+    uploaded_at datetime(3) NULL,
+    PRIMARY KEY (id),
+    INDEX idx_documents_driver_id (driver_id)
+);
@@ -0,0 +1 @@
+-- Onboarding statuses are left as they are, the column is dropped by the migration before
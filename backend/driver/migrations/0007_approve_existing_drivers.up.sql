@@ -0,0 +1,3 @@
+-- Drivers from before onboarding were already driving, so they count as approved
+UPDATE drivers SET onboarding_status = 'approved' WHERE onboarding_status IS NULL OR onboarding_status = '';
+UPDATE drivers SET available = (onboarding_status = 'approved' AND online AND active_trip_id = 0);
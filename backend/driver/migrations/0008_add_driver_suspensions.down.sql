@@ -0,0 +1,2 @@
+DROP TABLE driver_audit_logs;
+DROP TABLE suspensions;
@@ -0,0 +1,24 @@
+CREATE TABLE suspensions (
+    id bigint NOT NULL AUTO_INCREMENT,
+    driver_id bigint,
+    reason_code longtext,
+    note longtext,
+    effective_from datetime(3) NULL,
+    effective_until datetime(3) NULL,
+    applied boolean,
+    reinstated_at datetime(3) NULL,
+    PRIMARY KEY (id),
+    INDEX idx_suspensions_driver_id (driver_id)
+);
+
+CREATE TABLE driver_audit_logs (
+    id bigint NOT NULL AUTO_INCREMENT,
+    driver_id bigint,
+    action longtext,
+    reason_code longtext,
+    note longtext,
+    effective_at datetime(3) NULL,
+    recorded_at datetime(3) NULL,
+    PRIMARY KEY (id),
+    INDEX idx_driver_audit_logs_driver_id (driver_id)
+);
@@ -0,0 +1,2 @@
+DROP INDEX idx_drivers_deleted_at ON drivers;
+ALTER TABLE drivers DROP COLUMN deleted_at;
@@ -0,0 +1,2 @@
+ALTER TABLE drivers ADD COLUMN deleted_at datetime(3) NULL;
+CREATE INDEX idx_drivers_deleted_at ON drivers (deleted_at);
@@ -0,0 +1 @@
+ALTER TABLE drivers DROP COLUMN anonymised_at;
@@ -0,0 +1 @@
+ALTER TABLE drivers ADD COLUMN anonymised_at datetime(3) NULL;
@@ -0,0 +1,3 @@
+-- Only works once mobile numbers are decrypted, encrypted ones don't fit in a bigint
+ALTER TABLE drivers DROP COLUMN email_index;
+ALTER TABLE drivers MODIFY mobile_no bigint;
@@ -0,0 +1,2 @@
+DROP INDEX idx_vehicles_license_plate ON vehicles;
+DROP INDEX idx_drivers_email_unique ON drivers;
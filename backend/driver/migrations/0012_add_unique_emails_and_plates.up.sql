@@ -0,0 +1,2 @@
+CREATE UNIQUE INDEX idx_drivers_email_unique ON drivers (email_index);
+CREATE UNIQUE INDEX idx_vehicles_license_plate ON vehicles (license_plate);
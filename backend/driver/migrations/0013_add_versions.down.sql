@@ -0,0 +1,2 @@
+ALTER TABLE vehicles DROP COLUMN version;
+ALTER TABLE drivers DROP COLUMN version;
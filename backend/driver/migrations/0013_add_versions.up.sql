@@ -0,0 +1,2 @@
+ALTER TABLE drivers ADD COLUMN version bigint NOT NULL DEFAULT 1;
+ALTER TABLE vehicles ADD COLUMN version bigint NOT NULL DEFAULT 1;
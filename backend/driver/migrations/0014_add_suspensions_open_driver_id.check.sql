@@ -0,0 +1,6 @@
+-- Drivers with more than one active or scheduled suspension, all but one of each must be reinstated
+SELECT driver_id, GROUP_CONCAT(id ORDER BY id) AS suspension_ids
+FROM suspensions
+WHERE reinstated_at IS NULL
+GROUP BY driver_id
+HAVING COUNT(*) > 1;
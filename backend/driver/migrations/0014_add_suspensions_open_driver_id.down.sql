@@ -0,0 +1,2 @@
+DROP INDEX idx_suspensions_open_driver_id ON suspensions;
+ALTER TABLE suspensions DROP COLUMN open_driver_id;
@@ -0,0 +1,7 @@
+-- A suspension has its driver's id until it is lifted, so that a unique index stops concurrent
+-- requests from giving a driver two active or scheduled suspensions
+ALTER TABLE suspensions ADD COLUMN open_driver_id bigint NULL;
+
+UPDATE suspensions SET open_driver_id = driver_id WHERE reinstated_at IS NULL;
+
+CREATE UNIQUE INDEX idx_suspensions_open_driver_id ON suspensions (open_driver_id);
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"shared/httputil"
+	"shared/service"
+)
+
+//Documents a driver must upload before they can be reviewed
+var requiredDocumentTypes = []string{"licence", "vehicle_registration", "insurance"}
+
+//Uploads larger than this are rejected
+const maxDocumentSize = 10 << 20
+
+var errDocumentTooLarge = errors.New("document is larger than 10MB")
+
+//A document uploaded by a driver, the file itself is kept in the blob store
+type Document struct {
+	Id           int `gorm:"primaryKey"`
+	DriverId     int `gorm:"index"`
+	DocumentType string
+	ContentType  string
+	Size         int64
+	BlobKey      string `json:"-"`
+	UploadedAt   time.Time
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpGetDriverDocuments(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	documents := listDriverDocuments(r.Context(), id)
+
+	httputil.RespondWith(w, http.StatusOK, documentsToApi(documents))
+}
+
+//The request body is the file
+func httpUploadDriverDocument(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	document, err := uploadDriverDocument(r.Context(), id, params["documentType"], r.Header.Get("Content-Type"), r.Body)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusCreated, document.toApi())
+}
+
+func httpDownloadDriverDocument(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	document, file, err := downloadDriverDocument(r.Context(), id, params["documentType"])
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+	defer file.Close()
+
+	if document.ContentType != "" {
+		w.Header().Set("Content-Type", document.ContentType)
+	}
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, file)
+}
+
+func httpApproveDriver(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	message, err := approveDriver(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+func httpRejectDriver(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	var body struct {
+		Reason string
+	}
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
+
+	message, err := rejectDriver(r.Context(), id, body.Reason)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+func listDriverDocuments(ctx context.Context, driverId int) []Document {
+	db := db.WithContext(ctx)
+
+	var documents []Document
+	db.Where("driver_id = ?", driverId).Find(&documents)
+	return documents
+}
+
+/*
+Uploads one of the driver's documents from content.
+Uploading a document type again replaces it.
+Once every required document is uploaded the driver is put under review
+*/
+func uploadDriverDocument(ctx context.Context, driverId int, documentType string, contentType string, content io.Reader) (Document, error) {
+	db := db.WithContext(ctx)
+	driver, err := findDriver(ctx, driverId)
+	if err != nil {
+		return Document{}, err
+	}
+
+	if !isRequiredDocumentType(documentType) {
+		return Document{}, service.NewError(http.StatusBadRequest, "Unknown document type")
+	}
+
+	if driver.OnboardingStatus == "approved" || driver.OnboardingStatus == "suspended" {
+		return Document{}, service.NewError(http.StatusConflict, "Documents cannot be changed once approved")
+	}
+
+	blobKey := fmt.Sprintf("drivers/%d/%s", driver.Id, documentType)
+	body := &countingReader{reader: content}
+	err = blobStore.Put(blobKey, body)
+	if err != nil {
+		return Document{}, service.NewError(http.StatusBadRequest, "Upload failed: "+err.Error())
+	}
+	if body.count == 0 {
+		blobStore.Delete(blobKey)
+		return Document{}, service.NewError(http.StatusBadRequest, "Document is empty")
+	}
+
+	document := Document{
+		DriverId:     driver.Id,
+		DocumentType: documentType,
+	}
+	db.Where(document).FirstOrInit(&document)
+	document.ContentType = contentType
+	document.Size = body.count
+	document.BlobKey = blobKey
+	document.UploadedAt = time.Now()
+	db.Save(&document)
+
+	if driver.OnboardingStatus == "pending" && hasAllDocuments(driver.Id) {
+		db.Model(&driver).Updates(map[string]interface{}{
+			"OnboardingStatus": "under_review",
+			"OnboardingNote":   "",
+		})
+	}
+
+	return document, nil
+}
+
+//The returned file has to be closed
+func downloadDriverDocument(ctx context.Context, driverId int, documentType string) (Document, io.ReadCloser, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return Document{}, nil, err
+	}
+
+	var document Document
+	err = db.Where("driver_id = ? AND document_type = ?", driverId, documentType).First(&document).Error
+	if err != nil {
+		return document, nil, service.NewError(http.StatusNotFound, "Document doesn't exist")
+	}
+
+	file, err := blobStore.Get(document.BlobKey)
+	if err != nil {
+		return document, nil, service.NewError(http.StatusNotFound, "Document doesn't exist")
+	}
+	return document, file, nil
+}
+
+func approveDriver(ctx context.Context, id int) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	driver, err := findDriver(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if driver.OnboardingStatus != "under_review" {
+		return "", service.NewError(http.StatusConflict, "Only drivers under review can be approved")
+	}
+
+	db.Model(&driver).Updates(map[string]interface{}{
+		"OnboardingStatus": "approved",
+		"OnboardingNote":   "",
+	})
+	refreshAvailability(driver.Id)
+	recordDriverAudit(driver.Id, "approved", "", "", time.Now())
+
+	return fmt.Sprintf("Driver of ID %d approved", driver.Id), nil
+}
+
+/*
+Sends the driver back to pending with the reason,
+so that they can upload the documents again
+*/
+func rejectDriver(ctx context.Context, id int, reason string) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	driver, err := findDriver(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if driver.OnboardingStatus != "under_review" {
+		return "", service.NewError(http.StatusConflict, "Only drivers under review can be rejected")
+	}
+	err = service.RequireField(reason, "Reason")
+	if err != nil {
+		return "", err
+	}
+
+	db.Model(&driver).Updates(map[string]interface{}{
+		"OnboardingStatus": "pending",
+		"OnboardingNote":   reason,
+	})
+	recordDriverAudit(driver.Id, "rejected", "", reason, time.Now())
+
+	return fmt.Sprintf("Driver of ID %d rejected", driver.Id), nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func isRequiredDocumentType(documentType string) bool {
+	for _, requiredType := range requiredDocumentTypes {
+		if documentType == requiredType {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAllDocuments(driverId int) bool {
+	var count int64
+	db.Model(&Document{}).
+		Where("driver_id = ? AND document_type IN ?", driverId, requiredDocumentTypes).
+		Count(&count)
+	return int(count) == len(requiredDocumentTypes)
+}
+
+//Counts the bytes read so that empty uploads can be caught, and stops reading uploads larger than maxDocumentSize
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (counter *countingReader) Read(p []byte) (int, error) {
+	n, err := counter.reader.Read(p)
+	counter.count += int64(n)
+	if counter.count > maxDocumentSize {
+		return n, errDocumentTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"shared/httputil"
+	"shared/logging"
+	"shared/models"
+	"shared/pii"
+	"shared/proto/trippb"
+	"shared/rpc"
+	"shared/service"
+)
+
+//Everything held about a driver, for data subject access requests
+type DriverExport struct {
+	Driver      Driver
+	Vehicles    []Vehicle
+	Documents   []Document
+	Shifts      []Shift
+	Suspensions []Suspension
+	AuditLog    []DriverAuditLog
+	Trips       []models.Trip //as returned by the trip service
+	ExportedAt  time.Time
+}
+
+var tripClient trippb.TripServiceClient
+
+//Drivers' trips are exported from the trip microservice
+func initTripClient() {
+	conn, err := rpc.Dial(config.TripGrpcAddress)
+	if err != nil {
+		log.Fatal("Connecting to the trip microservice failed: " + err.Error())
+	}
+	tripClient = trippb.NewTripServiceClient(conn)
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+/*
+Exports the driver's profile, vehicles, shifts, account history and trips as JSON,
+or as a ZIP with one file for each and the uploaded documents when format=zip
+*/
+func httpExportDriver(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	export, err := exportDriver(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	if r.URL.Query().Get("format") != "zip" {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=driver-%d.json", export.Driver.Id))
+		httputil.RespondWith(w, http.StatusOK, export)
+		return
+	}
+
+	archive, err := zipDriverExport(export)
+	if err != nil {
+		logging.Error("Export could not be archived", map[string]interface{}{"requestId": logging.RequestId(r), "error": err.Error()})
+		httputil.RespondWithError(w, service.NewError(http.StatusInternalServerError, "Export could not be archived"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=driver-%d.zip", export.Driver.Id))
+	w.WriteHeader(http.StatusOK)
+	w.Write(archive)
+}
+
+func httpAnonymiseDriver(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	message, err := anonymiseDriver(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+func exportDriver(ctx context.Context, id int) (DriverExport, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return DriverExport{}, err
+	}
+
+	var driver Driver
+	err = db.Unscoped().Preload("Vehicles").Where("id = ?", id).First(&driver).Error
+	if err != nil {
+		return DriverExport{}, service.NewError(http.StatusNotFound, "User doesn't exist")
+	}
+
+	export := DriverExport{
+		Driver:     driver,
+		Vehicles:   driver.Vehicles,
+		ExportedAt: time.Now(),
+	}
+	db.Where("driver_id = ?", driver.Id).Find(&export.Documents)
+	db.Where("driver_id = ?", driver.Id).Order("started_at").Find(&export.Shifts)
+	db.Where("driver_id = ?", driver.Id).Order("effective_from").Find(&export.Suspensions)
+	db.Where("driver_id = ?", driver.Id).Order("recorded_at").Find(&export.AuditLog)
+
+	var tripsErr error
+	export.Trips, tripsErr = getDriverTrips(ctx, driver.Id)
+	if tripsErr != nil {
+		return DriverExport{}, service.NewError(http.StatusBadGateway, "Could not get trips: "+rpc.Message(tripsErr))
+	}
+	return export, nil
+}
+
+/*
+Scrubs the driver's personal data and uploaded documents but keeps the driver's Id,
+shifts and vehicles, so that their trips and hours still add up
+*/
+func anonymiseDriver(ctx context.Context, id int) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var driver Driver
+	err = db.Unscoped().Where("id = ?", id).First(&driver).Error
+	if err != nil {
+		return "", service.NewError(http.StatusNotFound, "User doesn't exist")
+	}
+	if driver.AnonymisedAt != nil {
+		return "", service.NewError(http.StatusConflict, "User is already anonymised")
+	}
+	if driver.ActiveTripId != 0 {
+		return "", service.NewError(http.StatusConflict, "Driver cannot be anonymised during a trip")
+	}
+
+	if driver.Online {
+		endShift(driver, "offline")
+	}
+
+	var documents []Document
+	db.Where("driver_id = ?", driver.Id).Find(&documents)
+	for _, document := range documents {
+		blobStore.Delete(document.BlobKey)
+	}
+	db.Where("driver_id = ?", driver.Id).Delete(&Document{})
+	db.Model(&Suspension{}).Where("driver_id = ?", driver.Id).Update("note", "")
+	db.Model(&DriverAuditLog{}).Where("driver_id = ?", driver.Id).Update("note", "")
+
+	//trips show the plate of their vehicle, so the plates of vehicles only they drove are scrubbed.
+	//Vehicles shared with other drivers keep theirs for those drivers' trips, and are only unlinked
+	var ownVehicleIds []int
+	db.Table("driver_vehicles").
+		Where("vehicle_id IN (?)", db.Table("driver_vehicles").Select("vehicle_id").Where("driver_id = ?", driver.Id)).
+		Group("vehicle_id").Having("COUNT(*) = 1").Pluck("vehicle_id", &ownVehicleIds)
+	for _, vehicleId := range ownVehicleIds {
+		db.Model(&Vehicle{}).Where("id = ?", vehicleId).Update("license_plate", fmt.Sprintf("ANONYMISED-%d", vehicleId))
+	}
+	db.Model(&driver).Association("Vehicles").Clear()
+
+	email := fmt.Sprintf("anonymised-%d@invalid", driver.Id)
+
+	db.Unscoped().Model(&driver).Updates(map[string]interface{}{
+		"FirstName":       "Anonymised",
+		"LastName":        "Driver",
+		"MobileNo":        pii.EncryptedInt(0),
+		"Email":           pii.EncryptedString(email),
+		"EmailIndex":      pii.EmailIndex(email),
+		"OnboardingNote":  "",
+		"CarLicenseNo":    "",
+		"ActiveVehicleId": 0,
+		"AnonymisedAt":    time.Now(),
+	})
+	recordDriverAudit(driver.Id, "anonymised", "", "", time.Now())
+
+	return fmt.Sprintf("User of ID %d successfully anonymised", driver.Id), nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+//Deleted trips are included as they are held until purged, which needs the admin password
+func getDriverTrips(ctx context.Context, driverId int) ([]models.Trip, error) {
+	ctx = rpc.WithAdminPassword(ctx, config.AdminPassword)
+	trips, err := tripClient.ListTrips(ctx, &trippb.ListTripsRequest{DriverId: int64(driverId), IncludeDeleted: true})
+	if err != nil {
+		return nil, err
+	}
+	return trips.ToModels(), nil
+}
+
+/*
+This function returns the export as a ZIP with one JSON file for each part and the uploaded documents.
+It is built before responding so that a part that can't be written fails the request rather than cutting off the ZIP
+*/
+func zipDriverExport(export DriverExport) ([]byte, error) {
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"driver.json", export.Driver},
+		{"vehicles.json", export.Vehicles},
+		{"documents.json", export.Documents},
+		{"shifts.json", export.Shifts},
+		{"suspensions.json", export.Suspensions},
+		{"auditLog.json", export.AuditLog},
+		{"trips.json", export.Trips},
+	}
+	for _, file := range files {
+		err := writeZipJson(archive, file.name, file.data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, document := range export.Documents {
+		err := writeZipBlob(archive, "documents/"+document.DocumentType, document.BlobKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := archive.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func writeZipJson(archive *zip.Writer, name string, data interface{}) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
+
+func writeZipBlob(archive *zip.Writer, name string, blobKey string) error {
+	blob, err := blobStore.Get(blobKey)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, blob)
+	return err
+}
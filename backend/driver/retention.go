@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"shared/httputil"
+	"shared/server"
+	"shared/service"
+)
+
+const purgeInterval = time.Hour
+
+/*
+This function hard deletes drivers that were deleted more than
+DELETED_RETENTION_DAYS ago, along with their documents, shifts and suspensions
+*/
+func startPurgeMonitor() {
+	retention := time.Duration(config.DeletedRetentionDays) * 24 * time.Hour
+
+	purgeDeletedDrivers(retention)
+
+	server.RunEvery(purgeInterval, func() {
+		purgeDeletedDrivers(retention)
+	})
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpRestoreDriver(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	message, err := restoreDriver(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+//Restored drivers come back offline and have to start a new shift
+func restoreDriver(ctx context.Context, id int) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var driver Driver
+	err = db.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&driver).Error
+	if err != nil {
+		return "", service.NewError(http.StatusNotFound, "Deleted user doesn't exist")
+	}
+
+	db.Unscoped().Model(&driver).Update("deleted_at", nil)
+	recordDriverAudit(driver.Id, "restored", "", "", time.Now())
+
+	return fmt.Sprintf("User of ID %d successfully restored", id), nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+//The audit log is kept after purging as a record of what happened to the account
+func purgeDeletedDrivers(retention time.Duration) {
+	var drivers []Driver
+	db.Unscoped().Where("deleted_at < ?", time.Now().Add(-retention)).Find(&drivers)
+
+	for _, driver := range drivers {
+		var documents []Document
+		db.Where("driver_id = ?", driver.Id).Find(&documents)
+		for _, document := range documents {
+			blobStore.Delete(document.BlobKey)
+		}
+		db.Where("driver_id = ?", driver.Id).Delete(&Document{})
+		db.Where("driver_id = ?", driver.Id).Delete(&Shift{})
+		db.Where("driver_id = ?", driver.Id).Delete(&Suspension{})
+		db.Unscoped().Model(&driver).Association("Vehicles").Clear()
+		db.Unscoped().Delete(&driver)
+	}
+}
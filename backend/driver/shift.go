@@ -0,0 +1,398 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"shared/httputil"
+	"shared/server"
+	"shared/service"
+)
+
+//Drivers that are online but idle for longer than this are taken offline
+const inactivityTimeout = 30 * time.Minute
+const inactivityCheckInterval = time.Minute
+
+//SQL for whether a driver can be matched to a trip
+const availableCondition = "(onboarding_status = 'approved' AND online AND active_trip_id = 0)"
+
+//SQL for whether a driver can change vehicles, which is only between shifts as shifts are counted against one vehicle
+const offShiftCondition = "(NOT online AND active_trip_id = 0)"
+
+//A period of time a driver was online for
+type Shift struct {
+	Id        int `gorm:"primaryKey"`
+	DriverId  int `gorm:"index"`
+	VehicleId int
+	StartedAt time.Time
+	EndedAt   *time.Time
+	EndReason string //"offline", "inactivity" or "suspended"
+}
+
+//Hours a driver was online on a day, dates are in local time as "2006-01-02"
+type DriverHours struct {
+	DriverId int
+	Date     string
+	Hours    float64
+}
+
+/*
+This function takes idle drivers offline every inactivityCheckInterval.
+Drivers on a trip are never taken offline
+*/
+func startInactivityMonitor() {
+	server.RunEvery(inactivityCheckInterval, func() {
+		var idleDrivers []Driver
+		db.Where("online = ? AND active_trip_id = ? AND last_active_at < ?",
+			true, 0, time.Now().Add(-inactivityTimeout)).Find(&idleDrivers)
+
+		for _, driver := range idleDrivers {
+			endShift(driver, "inactivity")
+		}
+	})
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpGoOnline(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	var body struct {
+		VehicleId int
+	}
+	//body is optional
+	json.NewDecoder(r.Body).Decode(&body)
+
+	shift, err := goOnline(r.Context(), id, body.VehicleId)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, shift.toApi())
+}
+
+func httpGoOffline(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	shift, err := goOffline(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, shift.toApi())
+}
+
+func httpDriverHeartbeat(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	message, err := driverHeartbeat(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+func httpSetActiveTrip(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	var body struct {
+		TripId         int
+		ReleasedTripId int
+	}
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
+
+	driver, err := setActiveTrip(r.Context(), id, body.TripId, body.ReleasedTripId)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, driver.toApi())
+}
+
+func httpGetDriverShifts(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	shifts := listDriverShifts(r.Context(), id)
+
+	httputil.RespondWith(w, http.StatusOK, shiftsToApi(shifts))
+}
+
+func httpGetShiftHours(w http.ResponseWriter, r *http.Request) {
+	urlParams := r.URL.Query()
+	driverId, _ := strconv.Atoi(urlParams.Get("driverId"))
+
+	hours, err := getShiftHours(r.Context(), urlParams.Get("from"), urlParams.Get("to"), driverId)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusOK, hours)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+/*
+Starts a shift. The driver can pick the vehicle to drive for the shift,
+otherwise their current active vehicle is used
+*/
+func goOnline(ctx context.Context, id int, vehicleId int) (Shift, error) {
+	db := db.WithContext(ctx)
+	driver, err := findDriver(ctx, id)
+	if err != nil {
+		return Shift{}, err
+	}
+	if driver.Online {
+		return Shift{}, service.NewError(http.StatusConflict, "Driver is already online")
+	}
+	if driver.OnboardingStatus != "approved" {
+		return Shift{}, service.NewError(http.StatusForbidden, "Driver has not been approved")
+	}
+
+	if vehicleId != 0 && vehicleId != driver.ActiveVehicleId {
+		var vehicles []Vehicle
+		db.Model(&driver).Where("id = ?", vehicleId).Association("Vehicles").Find(&vehicles)
+		if len(vehicles) == 0 {
+			return Shift{}, service.NewError(http.StatusBadRequest, "Vehicle is not registered to this driver")
+		}
+		driver.ActiveVehicleId = vehicles[0].Id
+		driver.CarLicenseNo = vehicles[0].LicensePlate
+	}
+	if driver.ActiveVehicleId == 0 {
+		return Shift{}, service.NewError(http.StatusBadRequest, "Driver has no vehicle to drive")
+	}
+
+	now := time.Now()
+	shift := Shift{
+		DriverId:  driver.Id,
+		VehicleId: driver.ActiveVehicleId,
+		StartedAt: now,
+	}
+	db.Create(&shift)
+
+	db.Model(&driver).Updates(map[string]interface{}{
+		"Online":          true,
+		"LastActiveAt":    now,
+		"ActiveVehicleId": driver.ActiveVehicleId,
+		"CarLicenseNo":    driver.CarLicenseNo,
+	})
+	refreshAvailability(driver.Id)
+
+	return shift, nil
+}
+
+func goOffline(ctx context.Context, id int) (Shift, error) {
+	driver, err := findDriver(ctx, id)
+	if err != nil {
+		return Shift{}, err
+	}
+	if !driver.Online {
+		return Shift{}, service.NewError(http.StatusConflict, "Driver is already offline")
+	}
+	if driver.ActiveTripId != 0 {
+		return Shift{}, service.NewError(http.StatusConflict, "Driver cannot go offline during a trip")
+	}
+
+	return endShift(driver, "offline"), nil
+}
+
+//Lets an online driver show they are still around so they aren't taken offline
+func driverHeartbeat(ctx context.Context, id int) (string, error) {
+	db := db.WithContext(ctx)
+	driver, err := findDriver(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	if driver.Online {
+		db.Model(&driver).Update("last_active_at", time.Now())
+	}
+	return "OK", nil
+}
+
+/*
+Sets the trip the driver is on, or 0 when releasedTripId is over.
+A driver is only available when they are approved, online and not on a trip,
+and can only be put on a trip while available or taken off the trip they are on
+*/
+func setActiveTrip(ctx context.Context, id int, tripId int, releasedTripId int) (Driver, error) {
+	db := db.WithContext(ctx)
+	driver, err := findDriver(ctx, id)
+	if err != nil {
+		return driver, err
+	}
+
+	//checked in the update so that a driver can't be put on two trips at once
+	query := db.Model(&Driver{}).Where("id = ?", driver.Id)
+	conflictMsg := "Driver is not available or is already on a trip"
+	if tripId != 0 {
+		query = query.Where("(active_trip_id = ? OR "+availableCondition+")", tripId)
+	} else {
+		err = service.RequireField(releasedTripId, "ReleasedTripId")
+		if err != nil {
+			return driver, err
+		}
+		query = query.Where("active_trip_id = ?", releasedTripId)
+		conflictMsg = fmt.Sprintf("Driver is not on trip %d", releasedTripId)
+	}
+	result := query.Updates(map[string]interface{}{
+		"ActiveTripId": tripId,
+		"LastActiveAt": time.Now(),
+	})
+	if result.Error != nil {
+		return driver, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	if result.RowsAffected == 0 {
+		return driver, service.NewError(http.StatusConflict, conflictMsg)
+	}
+	refreshAvailability(driver.Id)
+
+	var newDriver Driver
+	db.Where("id = ?", driver.Id).First(&newDriver)
+	return newDriver, nil
+}
+
+func listDriverShifts(ctx context.Context, driverId int) []Shift {
+	db := db.WithContext(ctx)
+
+	var shifts []Shift
+	db.Where("driver_id = ?", driverId).Order("started_at").Find(&shifts)
+	return shifts
+}
+
+/*
+Reports the hours each driver was online per day between the from and to dates (inclusive).
+Defaults to today, and can be narrowed to one driver with driverId
+*/
+func getShiftHours(ctx context.Context, fromDate string, toDate string, driverId int) ([]DriverHours, error) {
+	db := db.WithContext(ctx)
+
+	today := time.Now().Format("2006-01-02")
+	if fromDate == "" {
+		fromDate = today
+	}
+	if toDate == "" {
+		toDate = fromDate
+	}
+
+	from, fromErr := time.ParseInLocation("2006-01-02", fromDate, time.Local)
+	to, toErr := time.ParseInLocation("2006-01-02", toDate, time.Local)
+	if fromErr != nil || toErr != nil || to.Before(from) {
+		return nil, service.NewError(http.StatusBadRequest, "from and to must be dates like 2006-01-02")
+	}
+	to = to.AddDate(0, 0, 1)
+
+	query := db.Where("started_at < ? AND (ended_at IS NULL OR ended_at > ?)", to, from)
+	if driverId != 0 {
+		query = query.Where("driver_id = ?", driverId)
+	}
+	var shifts []Shift
+	query.Order("driver_id").Find(&shifts)
+
+	return hoursPerDay(shifts, from, to), nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func endShift(driver Driver, reason string) Shift {
+	now := time.Now()
+
+	var shift Shift
+	err := db.Where("driver_id = ? AND ended_at IS NULL", driver.Id).First(&shift).Error
+	if err == nil {
+		db.Model(&shift).Updates(map[string]interface{}{
+			"EndedAt":   now,
+			"EndReason": reason,
+		})
+		shift.EndedAt = &now
+		shift.EndReason = reason
+	}
+
+	//the driver may have just been deleted
+	db.Unscoped().Model(&driver).Update("online", false)
+	refreshAvailability(driver.Id)
+
+	return shift
+}
+
+func refreshAvailability(driverId int) {
+	db.Exec("UPDATE drivers SET available = "+availableCondition+" WHERE id = ?", driverId)
+}
+
+/*
+This function splits shifts at midnight and sums up the hours of each driver per day,
+only counting time between from and to. Unfinished shifts count up to now
+*/
+func hoursPerDay(shifts []Shift, from time.Time, to time.Time) []DriverHours {
+	hours := []DriverHours{}
+	index := map[string]int{}
+
+	for _, shift := range shifts {
+		start := shift.StartedAt
+		if start.Before(from) {
+			start = from
+		}
+		end := time.Now()
+		if shift.EndedAt != nil {
+			end = *shift.EndedAt
+		}
+		if end.After(to) {
+			end = to
+		}
+
+		for start.Before(end) {
+			year, month, day := start.Date()
+			midnight := time.Date(year, month, day+1, 0, 0, 0, 0, start.Location())
+			dayEnd := end
+			if midnight.Before(end) {
+				dayEnd = midnight
+			}
+
+			date := start.Format("2006-01-02")
+			key := fmt.Sprintf("%d/%s", shift.DriverId, date)
+			i, ok := index[key]
+			if !ok {
+				i = len(hours)
+				index[key] = i
+				hours = append(hours, DriverHours{DriverId: shift.DriverId, Date: date})
+			}
+			hours[i].Hours += dayEnd.Sub(start).Hours()
+
+			start = dayEnd
+		}
+	}
+
+	return hours
+}
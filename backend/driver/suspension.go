@@ -0,0 +1,355 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"gorm.io/gorm"
+
+	"shared/database"
+	"shared/httputil"
+	"shared/models"
+	"shared/server"
+	"shared/service"
+)
+
+const suspensionCheckInterval = time.Minute
+
+var suspensionReasonCodes = []string{"safety", "fraud", "documents_expired", "customer_complaints", "other"}
+
+/*
+A suspension takes effect at EffectiveFrom and lasts until EffectiveUntil,
+or until the driver is reinstated if EffectiveUntil is not set
+*/
+type Suspension struct {
+	Id             int `gorm:"primaryKey"`
+	DriverId       int `gorm:"index"`
+	ReasonCode     string
+	Note           string
+	EffectiveFrom  time.Time
+	EffectiveUntil *time.Time
+	Applied        bool //whether the driver has been suspended yet
+	ReinstatedAt   *time.Time
+	OpenDriverId   *int //DriverId until reinstated, unique so that a driver has one suspension at a time
+}
+
+//Append only record of actions taken on a driver's account
+type DriverAuditLog struct {
+	Id          int    `gorm:"primaryKey"`
+	DriverId    int    `gorm:"index"`
+	Action      string //"approved", "rejected", "suspended", "suspension_scheduled", "reinstated", "deleted", "restored" or "anonymised"
+	ReasonCode  string
+	Note        string
+	EffectiveAt time.Time
+	RecordedAt  time.Time
+}
+
+/*
+This function starts and ends suspensions as they become effective,
+so that suspensions can be scheduled ahead of time
+*/
+func startSuspensionMonitor() {
+	applyDueSuspensions()
+
+	server.RunEvery(suspensionCheckInterval, func() {
+		applyDueSuspensions()
+	})
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpSuspendDriver(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	var body models.Suspension
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
+
+	suspension, err := suspendDriver(r.Context(), id, suspensionFromApi(body))
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusCreated, suspension.toApi())
+}
+
+func httpReinstateDriver(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	var body struct {
+		Note string
+	}
+	//body is optional
+	json.NewDecoder(r.Body).Decode(&body)
+
+	message, err := reinstateDriver(r.Context(), id, body.Note)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+func httpGetDriverSuspension(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	suspension, err := getDriverSuspension(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusOK, suspension.toApi())
+}
+
+func httpGetDriverAuditLog(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	auditLog, err := getDriverAuditLog(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusOK, auditLog)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+func suspendDriver(ctx context.Context, id int, suspension Suspension) (Suspension, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return suspension, err
+	}
+
+	driver, err := findDriver(ctx, id)
+	if err != nil {
+		return suspension, err
+	}
+	if driver.OnboardingStatus != "approved" {
+		return suspension, service.NewError(http.StatusConflict, "Only approved drivers can be suspended")
+	}
+
+	if !isSuspensionReasonCode(suspension.ReasonCode) {
+		return suspension, service.NewError(http.StatusBadRequest, "ReasonCode field is missing/incorrect.")
+	}
+
+	//suspend immediately unless scheduled
+	now := time.Now()
+	if suspension.EffectiveFrom.IsZero() || suspension.EffectiveFrom.Before(now) {
+		suspension.EffectiveFrom = now
+	}
+	if suspension.EffectiveUntil != nil && !suspension.EffectiveUntil.After(suspension.EffectiveFrom) {
+		return suspension, service.NewError(http.StatusBadRequest, "EffectiveUntil must be after EffectiveFrom")
+	}
+
+	//Disallow manual setting of Id and state
+	suspension.Id = 0
+	suspension.DriverId = driver.Id
+	suspension.Applied = false
+	suspension.ReinstatedAt = nil
+	suspension.OpenDriverId = &driver.Id
+
+	//only one suspension can be active or scheduled at a time
+	err = db.Create(&suspension).Error
+	if database.IsDuplicateKeyError(err) {
+		return suspension, service.NewError(http.StatusConflict, "Driver already has a suspension")
+	}
+	if err != nil {
+		return suspension, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+
+	if suspension.EffectiveFrom.After(now) {
+		recordDriverAudit(driver.Id, "suspension_scheduled", suspension.ReasonCode, suspension.Note, suspension.EffectiveFrom)
+	} else {
+		applySuspension(suspension)
+	}
+
+	db.Where("id = ?", suspension.Id).First(&suspension)
+	return suspension, nil
+}
+
+/*
+Lifts the driver's active or scheduled suspension.
+Suspensions with an EffectiveUntil are lifted automatically
+*/
+func reinstateDriver(ctx context.Context, id int, note string) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	driver, err := findDriver(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	var suspension Suspension
+	err = db.Where("driver_id = ? AND reinstated_at IS NULL", driver.Id).First(&suspension).Error
+	if err != nil {
+		return "", service.NewError(http.StatusConflict, "Driver is not suspended")
+	}
+
+	err = liftSuspension(suspension, note)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Driver of ID %d reinstated", driver.Id), nil
+}
+
+//Returns the driver's active or scheduled suspension
+func getDriverSuspension(ctx context.Context, driverId int) (Suspension, error) {
+	db := db.WithContext(ctx)
+
+	var suspension Suspension
+	err := db.Where("driver_id = ? AND reinstated_at IS NULL", driverId).First(&suspension).Error
+	if err != nil {
+		return suspension, service.NewError(http.StatusNotFound, "Driver is not suspended")
+	}
+	return suspension, nil
+}
+
+func getDriverAuditLog(ctx context.Context, driverId int) ([]DriverAuditLog, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var auditLog []DriverAuditLog
+	db.Where("driver_id = ?", driverId).Order("recorded_at").Find(&auditLog)
+	return auditLog, nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func applyDueSuspensions() {
+	now := time.Now()
+
+	var dueSuspensions []Suspension
+	db.Where("applied = ? AND reinstated_at IS NULL AND effective_from <= ?", false, now).Find(&dueSuspensions)
+	for _, suspension := range dueSuspensions {
+		applySuspension(suspension)
+	}
+
+	var endedSuspensions []Suspension
+	db.Where("applied = ? AND reinstated_at IS NULL AND effective_until <= ?", true, now).Find(&endedSuspensions)
+	for _, suspension := range endedSuspensions {
+		liftSuspension(suspension, "Suspension ended")
+	}
+}
+
+/*
+This function suspends the driver, taking them offline.
+A driver on a trip can finish it but will not be matched again
+*/
+func applySuspension(suspension Suspension) {
+	var driver Driver
+	err := db.Where("id = ?", suspension.DriverId).First(&driver).Error
+	if err != nil {
+		return
+	}
+
+	//the suspension is only applied once and never after it is lifted, and to a driver who is still approved
+	suspended := false
+	db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Suspension{}).Where("id = ? AND applied = ? AND reinstated_at IS NULL", suspension.Id, false).
+			Update("applied", true)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		result = tx.Model(&Driver{}).Where("id = ? AND onboarding_status = ?", driver.Id, "approved").
+			Update("onboarding_status", "suspended")
+		suspended = result.Error == nil && result.RowsAffected > 0
+		return result.Error
+	})
+	if !suspended {
+		return
+	}
+
+	if driver.Online {
+		endShift(driver, "suspended")
+	}
+	refreshAvailability(driver.Id)
+
+	recordDriverAudit(driver.Id, "suspended", suspension.ReasonCode, suspension.Note, suspension.EffectiveFrom)
+}
+
+/*
+Lifts the suspension, which fails with 409 Conflict if it has been already.
+Only a suspended driver is approved again, as a scheduled suspension never changed the driver's status
+*/
+func liftSuspension(suspension Suspension, note string) error {
+	now := time.Now()
+	lifted := false
+	txErr := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Suspension{}).Where("id = ? AND reinstated_at IS NULL", suspension.Id).
+			Updates(map[string]interface{}{"reinstated_at": now, "open_driver_id": nil})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		lifted = true
+		return tx.Model(&Driver{}).Where("id = ? AND onboarding_status = ?", suspension.DriverId, "suspended").
+			Update("onboarding_status", "approved").Error
+	})
+	if txErr != nil {
+		return service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	if !lifted {
+		return service.NewError(http.StatusConflict, "Driver is not suspended")
+	}
+	refreshAvailability(suspension.DriverId)
+
+	recordDriverAudit(suspension.DriverId, "reinstated", suspension.ReasonCode, note, now)
+	return nil
+}
+
+func recordDriverAudit(driverId int, action string, reasonCode string, note string, effectiveAt time.Time) {
+	entry := DriverAuditLog{
+		DriverId:    driverId,
+		Action:      action,
+		ReasonCode:  reasonCode,
+		Note:        note,
+		EffectiveAt: effectiveAt,
+		RecordedAt:  time.Now(),
+	}
+	db.Create(&entry)
+}
+
+func isSuspensionReasonCode(reasonCode string) bool {
+	for _, code := range suspensionReasonCodes {
+		if reasonCode == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,488 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"gorm.io/gorm"
+
+	"shared/database"
+	"shared/httputil"
+	"shared/models"
+	"shared/service"
+)
+
+//A vehicle can be driven by many drivers (e.g. a fleet car) and a driver can have many vehicles
+type Vehicle struct {
+	Id           int    `gorm:"primaryKey"`
+	LicensePlate string `gorm:"uniqueIndex;size:16"`
+	Make         string
+	Model        string
+	Colour       string
+	Seats        int    //passenger seats, excluding the driver
+	VehicleClass string //"standard", "xl", "premium" or "wheelchair"
+	Version      int    `gorm:"not null;default:1"` //bumped on every change, sent as the ETag
+
+	Drivers []Driver `gorm:"many2many:driver_vehicles;" json:"-"`
+}
+
+//Rolls back deleting a vehicle that changed after If-Match was checked
+var errVersionChanged = errors.New("version changed")
+
+//Rolls back deleting a vehicle that a driver is online in
+var errVehicleInUse = errors.New("vehicle in use")
+
+var vehicleClasses = []string{"standard", "xl", "premium", "wheelchair"}
+
+//Vehicle classes that can serve each requested ride class, a standard ride can be upgraded
+var eligibleVehicleClasses = map[string][]string{
+	"standard":   {"standard", "xl", "premium"},
+	"xl":         {"xl"},
+	"premium":    {"premium"},
+	"wheelchair": {"wheelchair"},
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpGetVehicles(w http.ResponseWriter, r *http.Request) {
+	vehicles := listVehicles(r.Context(), r.URL.Query().Get("licensePlate"))
+
+	httputil.RespondWith(w, http.StatusOK, vehiclesToApi(vehicles))
+}
+
+func httpGetVehicleById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	vehicle, err := findVehicle(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.SetETag(w, vehicle.Version)
+	httputil.RespondWith(w, http.StatusOK, vehicle.toApi())
+}
+
+func httpCreateVehicle(w http.ResponseWriter, r *http.Request) {
+	var body models.Vehicle
+
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
+
+	vehicle, err := createVehicle(r.Context(), vehicleFromApi(body))
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.SetETag(w, vehicle.Version)
+	httputil.RespondWith(w, http.StatusCreated, vehicle.toApi())
+}
+
+func httpUpdateVehicle(w http.ResponseWriter, r *http.Request) {
+	var body models.Vehicle
+
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	vehicle, err := updateVehicle(r.Context(), id, vehicleFromApi(body), httputil.IfMatch(r))
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.SetETag(w, vehicle.Version)
+	httputil.RespondWith(w, http.StatusAccepted, vehicle.toApi())
+}
+
+func httpDeleteVehicle(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	message, err := deleteVehicle(r.Context(), id, httputil.IfMatch(r))
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+func httpGetDriverVehicles(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	vehicles, err := listDriverVehicles(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusOK, vehiclesToApi(vehicles))
+}
+
+func httpAddDriverVehicle(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+	vehicleId, _ := strconv.Atoi(params["vehicleId"])
+
+	vehicle, err := addDriverVehicle(r.Context(), id, vehicleId)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, vehicle.toApi())
+}
+
+func httpRemoveDriverVehicle(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+	vehicleId, _ := strconv.Atoi(params["vehicleId"])
+
+	message, err := removeDriverVehicle(r.Context(), id, vehicleId)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+func httpSetActiveVehicle(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	var body struct {
+		VehicleId int
+	}
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
+
+	vehicle, err := setActiveVehicle(r.Context(), id, body.VehicleId)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, vehicle.toApi())
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+//Lists the vehicles with the license plate, or all of them if it's empty
+func listVehicles(ctx context.Context, licensePlate string) []Vehicle {
+	db := db.WithContext(ctx)
+	var vehicles []Vehicle
+
+	if licensePlate != "" {
+		db.Where("license_plate = ?", licensePlate).Find(&vehicles)
+	} else {
+		db.Find(&vehicles)
+	}
+	return vehicles
+}
+
+func createVehicle(ctx context.Context, vehicle Vehicle) (Vehicle, error) {
+	db := db.WithContext(ctx)
+
+	if vehicle.VehicleClass == "" {
+		vehicle.VehicleClass = "standard"
+	}
+
+	err := validateVehicle(vehicle)
+	if err != nil {
+		return vehicle, err
+	}
+
+	//validate plate exist
+	var existing Vehicle
+	if db.Where("license_plate = ?", vehicle.LicensePlate).First(&existing).Error == nil {
+		return vehicle, service.NewError(http.StatusConflict, "License plate already registered.")
+	}
+
+	//Disallow manual setting of Id and Version
+	vehicle.Id = 0
+	vehicle.Version = 1
+
+	dbErr := db.Create(&vehicle).Error
+	if database.IsDuplicateKeyError(dbErr) {
+		return vehicle, service.NewError(http.StatusConflict, "License plate already registered.")
+	}
+	if dbErr != nil {
+		return vehicle, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	return vehicle, nil
+}
+
+func updateVehicle(ctx context.Context, id int, vehicle Vehicle, ifMatch service.IfMatch) (Vehicle, error) {
+	db := db.WithContext(ctx)
+
+	err := validateVehicle(vehicle)
+	if err != nil {
+		return vehicle, err
+	}
+
+	oldVehicle, err := findVehicle(ctx, id)
+	if err != nil {
+		return oldVehicle, err
+	}
+	err = ifMatch.Check(oldVehicle.Version)
+	if err != nil {
+		return oldVehicle, err
+	}
+
+	result := db.Model(&Vehicle{}).Where("id = ? AND version = ?", id, oldVehicle.Version).Updates(map[string]interface{}{
+		"LicensePlate": vehicle.LicensePlate,
+		"Make":         vehicle.Make,
+		"Model":        vehicle.Model,
+		"Colour":       vehicle.Colour,
+		"Seats":        vehicle.Seats,
+		"VehicleClass": vehicle.VehicleClass,
+	})
+	if database.IsDuplicateKeyError(result.Error) {
+		return oldVehicle, service.NewError(http.StatusConflict, "License plate already registered.")
+	}
+	if result.Error != nil {
+		return oldVehicle, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	if result.RowsAffected == 0 {
+		return oldVehicle, service.VersionChanged()
+	}
+
+	var newVehicle Vehicle
+	db.Where("id = ?", id).First(&newVehicle)
+
+	//keep the plate of drivers currently using this vehicle in sync
+	db.Model(&Driver{}).Where("active_vehicle_id = ?", newVehicle.Id).Update("car_license_no", newVehicle.LicensePlate)
+
+	return newVehicle, nil
+}
+
+func deleteVehicle(ctx context.Context, id int, ifMatch service.IfMatch) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	vehicle, err := findVehicle(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	err = ifMatch.Check(vehicle.Version)
+	if err != nil {
+		return "", err
+	}
+
+	//unlink from all drivers before deleting, and undo it if the vehicle changed in the meantime
+	//or a driver is online in it
+	txErr := db.Transaction(func(tx *gorm.DB) error {
+		tx.Model(&vehicle).Association("Drivers").Clear()
+		tx.Model(&Driver{}).Where("active_vehicle_id = ? AND "+offShiftCondition, vehicle.Id).
+			Updates(map[string]interface{}{"ActiveVehicleId": 0, "CarLicenseNo": ""})
+
+		var onlineDrivers int64
+		tx.Model(&Driver{}).Where("active_vehicle_id = ?", vehicle.Id).Count(&onlineDrivers)
+		if onlineDrivers > 0 {
+			return errVehicleInUse
+		}
+
+		result := tx.Where("version = ?", vehicle.Version).Delete(&vehicle)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errVersionChanged
+		}
+		return nil
+	})
+	if txErr == errVersionChanged {
+		return "", service.VersionChanged()
+	}
+	if txErr == errVehicleInUse {
+		return "", service.NewError(http.StatusConflict, "Vehicle cannot be deleted while a driver is online in it")
+	}
+	if txErr != nil {
+		return "", service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+
+	return fmt.Sprintf("Vehicle of ID %d successfully deleted", id), nil
+}
+
+func listDriverVehicles(ctx context.Context, driverId int) ([]Vehicle, error) {
+	db := db.WithContext(ctx)
+
+	var driver Driver
+	err := db.Preload("Vehicles").Where("id = ?", driverId).First(&driver).Error
+	if err != nil {
+		return nil, service.NewError(http.StatusNotFound, "User doesn't exist")
+	}
+	return driver.Vehicles, nil
+}
+
+/*
+Registers a vehicle to a driver. Only admins can register a vehicle that another driver
+already has, so drivers can't take over someone else's vehicle
+*/
+func addDriverVehicle(ctx context.Context, driverId int, vehicleId int) (Vehicle, error) {
+	db := db.WithContext(ctx)
+	driver, vehicle, err := findDriverAndVehicle(ctx, driverId, vehicleId)
+	if err != nil {
+		return vehicle, err
+	}
+
+	if service.IsAdmin(ctx) {
+		db.Model(&driver).Association("Vehicles").Append(&vehicle)
+		return vehicle, nil
+	}
+
+	//checked in the insert so two drivers can't both register the same vehicle
+	result := db.Exec(`INSERT IGNORE INTO driver_vehicles (driver_id, vehicle_id)
+		SELECT ?, ? FROM DUAL
+		WHERE NOT EXISTS (SELECT 1 FROM driver_vehicles WHERE vehicle_id = ? AND driver_id <> ?)`,
+		driver.Id, vehicle.Id, vehicle.Id, driver.Id)
+	if result.Error != nil {
+		return vehicle, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	if result.RowsAffected == 0 {
+		//nothing is inserted when the driver already has the vehicle too
+		var links int64
+		db.Table("driver_vehicles").Where("driver_id = ? AND vehicle_id = ?", driver.Id, vehicle.Id).Count(&links)
+		if links == 0 {
+			return vehicle, service.NewError(http.StatusConflict, "Vehicle is registered to another driver")
+		}
+	}
+	return vehicle, nil
+}
+
+func removeDriverVehicle(ctx context.Context, driverId int, vehicleId int) (string, error) {
+	db := db.WithContext(ctx)
+	driver, vehicle, err := findDriverAndVehicle(ctx, driverId, vehicleId)
+	if err != nil {
+		return "", err
+	}
+
+	//a driver can't stay on a vehicle that isn't theirs, so it can only be removed between shifts
+	if driver.ActiveVehicleId == vehicle.Id {
+		result := db.Model(&Driver{}).Where("id = ? AND active_vehicle_id = ? AND "+offShiftCondition, driver.Id, vehicle.Id).
+			Updates(map[string]interface{}{"ActiveVehicleId": 0, "CarLicenseNo": ""})
+		if result.RowsAffected == 0 {
+			return "", service.NewError(http.StatusConflict, "Vehicle cannot be removed while the driver is online in it")
+		}
+		refreshAvailability(driver.Id)
+	}
+
+	db.Model(&driver).Association("Vehicles").Delete(&vehicle)
+
+	return fmt.Sprintf("Vehicle of ID %d removed from driver", vehicle.Id), nil
+}
+
+/*
+Sets the vehicle the driver drives on their next shift.
+The vehicle must already be linked to the driver, and an online driver keeps their shift's vehicle
+*/
+func setActiveVehicle(ctx context.Context, driverId int, vehicleId int) (Vehicle, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireField(vehicleId, "VehicleId")
+	if err != nil {
+		return Vehicle{}, err
+	}
+
+	var driver Driver
+	err = db.Preload("Vehicles", "id = ?", vehicleId).Where("id = ?", driverId).First(&driver).Error
+	if err != nil {
+		return Vehicle{}, service.NewError(http.StatusNotFound, "User doesn't exist")
+	}
+	if len(driver.Vehicles) == 0 {
+		return Vehicle{}, service.NewError(http.StatusBadRequest, "Vehicle is not registered to this driver")
+	}
+
+	vehicle := driver.Vehicles[0]
+	result := db.Model(&Driver{}).Where("id = ? AND "+offShiftCondition, driver.Id).Updates(map[string]interface{}{
+		"ActiveVehicleId": vehicle.Id,
+		"CarLicenseNo":    vehicle.LicensePlate,
+	})
+	if result.RowsAffected == 0 && driver.ActiveVehicleId != vehicle.Id {
+		return Vehicle{}, service.NewError(http.StatusConflict, "Driver cannot change vehicle while online, go offline first")
+	}
+	return vehicle, nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+//Returns an error for the first of the vehicle's fields that is invalid
+func validateVehicle(vehicle Vehicle) error {
+	err := service.FirstError(
+		service.RequireField(vehicle.LicensePlate, "LicensePlate"),
+		service.RequireField(vehicle.Make, "Make"),
+		service.RequireField(vehicle.Model, "Model"),
+		service.RequireField(vehicle.Colour, "Colour"),
+		service.RequireField(vehicle.Seats, "Seats"),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, vehicleClass := range vehicleClasses {
+		if vehicle.VehicleClass == vehicleClass {
+			return nil
+		}
+	}
+	return service.NewError(http.StatusBadRequest, "VehicleClass field is missing/incorrect.")
+}
+
+func findVehicle(ctx context.Context, id int) (Vehicle, error) {
+	db := db.WithContext(ctx)
+
+	var vehicle Vehicle
+	err := db.Where("id = ?", id).First(&vehicle).Error
+	if err != nil {
+		return vehicle, service.NewError(http.StatusNotFound, "Vehicle doesn't exist")
+	}
+	return vehicle, nil
+}
+
+func findDriverAndVehicle(ctx context.Context, driverId int, vehicleId int) (Driver, Vehicle, error) {
+	driver, err := findDriver(ctx, driverId)
+	if err != nil {
+		return driver, Vehicle{}, err
+	}
+
+	vehicle, err := findVehicle(ctx, vehicleId)
+	if err != nil {
+		return driver, vehicle, err
+	}
+
+	return driver, vehicle, nil
+}
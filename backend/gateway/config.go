@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+
+	"shared/settings"
+)
+
+//The gateway has the settings of settings.ServerSettings, see shared/settings for how they're loaded
+type Config struct {
+	settings.Service
+	PassengerServiceUrl  string
+	DriverServiceUrl     string
+	TripServiceUrl       string
+	PassengerGrpcAddress string
+	DriverGrpcAddress    string
+	TripGrpcAddress      string
+	CorsOrigins          string
+	RateLimit            int
+	RateBurst            int
+}
+
+var config Config
+
+const defaultConfigFile = "../.env"
+
+func defaultConfig() Config {
+	return Config{
+		Service:              settings.DefaultService(8000),
+		PassengerServiceUrl:  "http://localhost:5000",
+		DriverServiceUrl:     "http://localhost:5001",
+		TripServiceUrl:       "http://localhost:5002",
+		PassengerGrpcAddress: "localhost:6000",
+		DriverGrpcAddress:    "localhost:6001",
+		TripGrpcAddress:      "localhost:6002",
+		CorsOrigins:          "*",
+		RateLimit:            20,
+		RateBurst:            40,
+	}
+}
+
+func configSettings() []*settings.Setting {
+	return append(config.Service.ServerSettings("GATEWAY_PORT"),
+		&settings.Setting{Name: "PASSENGER_SERVICE_URL", Value: &config.PassengerServiceUrl, Usage: "URL of the passenger microservice"},
+		&settings.Setting{Name: "DRIVER_SERVICE_URL", Value: &config.DriverServiceUrl, Usage: "URL of the driver microservice"},
+		&settings.Setting{Name: "TRIP_SERVICE_URL", Value: &config.TripServiceUrl, Usage: "URL of the trip microservice"},
+		&settings.Setting{Name: "PASSENGER_GRPC_ADDRESS", Value: &config.PassengerGrpcAddress, Usage: "host:port of the passenger microservice's gRPC server"},
+		&settings.Setting{Name: "DRIVER_GRPC_ADDRESS", Value: &config.DriverGrpcAddress, Usage: "host:port of the driver microservice's gRPC server"},
+		&settings.Setting{Name: "TRIP_GRPC_ADDRESS", Value: &config.TripGrpcAddress, Usage: "host:port of the trip microservice's gRPC server"},
+		&settings.Setting{Name: "CORS_ORIGINS", Value: &config.CorsOrigins, Usage: "comma separated origins browsers can call the gateway from, * for any"},
+		&settings.Setting{Name: "RATE_LIMIT", Value: &config.RateLimit, Usage: "requests a second each client can make on average"},
+		&settings.Setting{Name: "RATE_BURST", Value: &config.RateBurst, Usage: "requests each client can make at once before RATE_LIMIT applies"},
+	)
+}
+
+//Returns a message for each invalid setting
+func validateConfig() []string {
+	problems := config.Service.ValidateServer("GATEWAY_PORT")
+	for _, s := range configSettings() {
+		if strings.HasSuffix(s.Name, "_SERVICE_URL") && !settings.IsHttpUrl(s.String()) {
+			problems = append(problems, s.Name+" must be a http or https URL")
+		}
+		if strings.HasSuffix(s.Name, "_GRPC_ADDRESS") && s.String() == "" {
+			problems = append(problems, s.Name+" is required")
+		}
+	}
+	if config.RateLimit < 1 || config.RateBurst < 1 {
+		problems = append(problems, "RATE_LIMIT and RATE_BURST must be at least 1")
+	}
+	return problems
+}
+
+/*
+This function loads the config into the global "config" and returns the arguments left after the flags.
+It stops the program if the config is invalid, or after printing it for --print-config
+*/
+func loadConfig() []string {
+	config = defaultConfig()
+	return settings.Load(defaultConfigFile, configSettings(), validateConfig)
+}
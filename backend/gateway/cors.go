@@ -0,0 +1,61 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+)
+
+//What browsers on the allowed origins can send and read
+const corsAllowedMethods = "GET, POST, PUT, DELETE"
+const corsAllowedHeaders = "Content-Type, If-Match, X-Request-ID, traceparent"
+const corsExposedHeaders = "ETag, X-Request-ID, Retry-After"
+
+/////////////////////////
+//                     //
+//     Middlewares     //
+//                     //
+/////////////////////////
+
+/*
+Lets browsers on CORS_ORIGINS call the gateway. Preflight requests are answered here
+and not passed on, as the microservices don't handle OPTIONS
+*/
+func corsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		allowed := origin != "" && isAllowedOrigin(origin)
+		if allowed {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Add("Vary", "Origin")
+			w.Header().Set("Access-Control-Expose-Headers", corsExposedHeaders)
+		}
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			if allowed {
+				w.Header().Set("Access-Control-Allow-Methods", corsAllowedMethods)
+				w.Header().Set("Access-Control-Allow-Headers", corsAllowedHeaders)
+				w.Header().Set("Access-Control-Max-Age", "600")
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func isAllowedOrigin(origin string) bool {
+	for _, allowedOrigin := range strings.Split(config.CorsOrigins, ",") {
+		allowedOrigin = strings.TrimSpace(allowedOrigin)
+		if allowedOrigin == "*" || allowedOrigin == origin {
+			return true
+		}
+	}
+	return false
+}
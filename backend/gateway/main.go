@@ -0,0 +1,73 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
+
+	"shared/logging"
+	"shared/metrics"
+	"shared/openapi"
+	"shared/server"
+	"shared/tracing"
+)
+
+const serviceName = "gateway"
+
+func main() {
+	loadConfig()
+	logging.Init(serviceName)
+	tracing.Init(serviceName, config.Service)
+
+	initGrpcClients()
+	startBucketCleanup()
+	initRouter()
+}
+
+func initRouter() {
+	router := mux.NewRouter()
+
+	router.HandleFunc("/healthz", server.GetHealth).Methods("GET")
+	router.HandleFunc("/readyz", server.GetBackendReadiness(map[string]string{
+		"passenger": config.PassengerServiceUrl,
+		"driver":    config.DriverServiceUrl,
+		"trip":      config.TripServiceUrl,
+	})).Methods("GET")
+	router.Use(otelmux.Middleware(serviceName))
+	router.Use(logging.Middleware)
+	router.Use(corsMiddleware)
+	router.Use(rateLimitMiddleware)
+	router.Use(authMiddleware)
+	metrics.Init(router, nil, rateLimitedRequests)
+	openapi.Init(router, "openapi.yaml")
+
+	router.HandleFunc("/trips/details", httpListTripDetails).Methods("GET")
+	router.HandleFunc("/trips/{id}/details", httpGetTripDetails).Methods("GET")
+
+	//everything else is passed on to the microservice it belongs to
+	backends := map[string]http.Handler{
+		"passenger": newProxy(config.PassengerServiceUrl),
+		"driver":    newProxy(config.DriverServiceUrl),
+		"trip":      newProxy(config.TripServiceUrl),
+	}
+	routes := []struct {
+		path    string
+		backend string
+	}{
+		{"/passengers", "passenger"},
+		{"/drivers", "driver"},
+		{"/vehicles", "driver"},
+		{"/shifts", "driver"},
+		{"/trips", "trip"},
+		{"/fares", "trip"},
+		{"/surge", "trip"},
+		{"/promoCodes", "trip"},
+	}
+	for _, route := range routes {
+		router.Path(route.path).Handler(backends[route.backend])
+		router.PathPrefix(route.path + "/").Handler(backends[route.backend])
+	}
+
+	server.Start("Gateway", router, nil, config.Service, nil)
+}
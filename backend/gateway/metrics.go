@@ -0,0 +1,10 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var rateLimitedRequests = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "hytchhyke_gateway_rate_limited_total",
+	Help: "Requests refused with 429 Too Many Requests",
+})
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"net/http"
+	nethttputil "net/http/httputil"
+	"net/url"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"shared/httputil"
+	"shared/logging"
+	"shared/service"
+)
+
+/////////////////////////
+//                     //
+//     Middlewares     //
+//                     //
+/////////////////////////
+
+/*
+The gateway is where the admin password is checked. A wrong one is refused here,
+and the microservices are only ever sent the gateway's own, see newProxy
+*/
+func authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, given := r.URL.Query()["adminPassword"]
+		isAdmin := httputil.HasValidAdminPass(r, config.AdminPassword)
+		if given && !isAdmin {
+			httputil.RespondWith(w, http.StatusForbidden, "Unauthorized User")
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(service.WithAdmin(r.Context(), isAdmin)))
+	})
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+/*
+This function returns a handler that passes requests on to the microservice at backendUrl unchanged,
+apart from the admin password, and responds with 502 Bad Gateway if it can't be reached
+*/
+func newProxy(backendUrl string) http.Handler {
+	target, _ := url.Parse(backendUrl)
+	proxy := nethttputil.NewSingleHostReverseProxy(target)
+	proxy.Transport = otelhttp.NewTransport(http.DefaultTransport)
+
+	direct := proxy.Director
+	proxy.Director = func(r *http.Request) {
+		direct(r)
+		r.Header.Set(logging.RequestIdHeader, logging.RequestId(r))
+
+		query := r.URL.Query()
+		query.Del("adminPassword")
+		if service.IsAdmin(r.Context()) {
+			query.Set("adminPassword", config.AdminPassword)
+		}
+		r.URL.RawQuery = query.Encode()
+	}
+
+	//the gateway has already sent back the request ID
+	proxy.ModifyResponse = func(resp *http.Response) error {
+		resp.Header.Del(logging.RequestIdHeader)
+		return nil
+	}
+
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		logging.Error("Microservice unreachable", map[string]interface{}{"backend": backendUrl, "error": err.Error()})
+		httputil.RespondWith(w, http.StatusBadGateway, "Microservice unavailable")
+	}
+	return proxy
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"shared/httputil"
+	"shared/server"
+)
+
+//Clients idle for this long are forgotten, and start again with a full bucket
+const bucketIdleTimeout = 10 * time.Minute
+
+/*
+Each client has a bucket of RATE_BURST tokens, refilled at RATE_LIMIT a second,
+and every request takes one. Clients are told by their IP address
+*/
+type tokenBucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+var buckets = map[string]*tokenBucket{}
+var bucketsMutex sync.Mutex
+
+/////////////////////////
+//                     //
+//     Middlewares     //
+//                     //
+/////////////////////////
+
+//Responds with 429 Too Many Requests to clients that have used up their bucket
+func rateLimitMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wait := takeToken(clientIp(r), time.Now())
+		if wait > 0 {
+			rateLimitedRequests.Inc()
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			httputil.RespondWith(w, http.StatusTooManyRequests, "Too many requests, try again later")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+/*
+This function takes a token from client's bucket, returning 0 if it had one
+or how long until it will if not
+*/
+func takeToken(client string, now time.Time) time.Duration {
+	bucketsMutex.Lock()
+	defer bucketsMutex.Unlock()
+
+	bucket, ok := buckets[client]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(config.RateBurst), updatedAt: now}
+		buckets[client] = bucket
+	}
+
+	refilled := now.Sub(bucket.updatedAt).Seconds() * float64(config.RateLimit)
+	bucket.tokens = math.Min(bucket.tokens+refilled, float64(config.RateBurst))
+	bucket.updatedAt = now
+
+	if bucket.tokens < 1 {
+		return time.Duration((1 - bucket.tokens) / float64(config.RateLimit) * float64(time.Second))
+	}
+	bucket.tokens--
+	return 0
+}
+
+//Forgets the buckets of clients that haven't made a request in a while, so that they don't pile up
+func startBucketCleanup() {
+	server.RunEvery(time.Minute, func() {
+		bucketsMutex.Lock()
+		defer bucketsMutex.Unlock()
+
+		for client, bucket := range buckets {
+			if time.Since(bucket.updatedAt) > bucketIdleTimeout {
+				delete(buckets, client)
+			}
+		}
+	})
+}
+
+//The gateway is what clients connect to, so the address of the connection is theirs
+func clientIp(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
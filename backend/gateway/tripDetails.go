@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"shared/httputil"
+	"shared/logging"
+	"shared/models"
+	"shared/proto/driverpb"
+	"shared/proto/passengerpb"
+	"shared/proto/trippb"
+	"shared/rpc"
+	"shared/service"
+)
+
+//Composite views are put together from the microservices over gRPC
+var passengerClient passengerpb.PassengerServiceClient
+var driverClient driverpb.DriverServiceClient
+var vehicleClient driverpb.VehicleServiceClient
+var tripClient trippb.TripServiceClient
+
+func initGrpcClients() {
+	passengerConn, err := rpc.Dial(config.PassengerGrpcAddress)
+	if err != nil {
+		log.Fatal("Connecting to the passenger microservice failed: " + err.Error())
+	}
+	driverConn, err := rpc.Dial(config.DriverGrpcAddress)
+	if err != nil {
+		log.Fatal("Connecting to the driver microservice failed: " + err.Error())
+	}
+	tripConn, err := rpc.Dial(config.TripGrpcAddress)
+	if err != nil {
+		log.Fatal("Connecting to the trip microservice failed: " + err.Error())
+	}
+
+	passengerClient = passengerpb.NewPassengerServiceClient(passengerConn)
+	driverClient = driverpb.NewDriverServiceClient(driverConn)
+	vehicleClient = driverpb.NewVehicleServiceClient(driverConn)
+	tripClient = trippb.NewTripServiceClient(tripConn)
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+//A trip with the names of its passenger and driver and its vehicle's plate in one call instead of four
+func httpGetTripDetails(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	details, err := getTripDetails(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusOK, details)
+}
+
+//A passenger's or driver's trips, each with the same details as GET /trips/{id}/details
+func httpListTripDetails(w http.ResponseWriter, r *http.Request) {
+	passengerId, _ := strconv.Atoi(r.URL.Query().Get("passengerId"))
+	driverId, _ := strconv.Atoi(r.URL.Query().Get("driverId"))
+
+	details, err := listTripDetails(r.Context(), passengerId, driverId)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusOK, details)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+/*
+This function gets the trip and adds who and what it was with, see addTripDetails.
+It fails with 404 Not Found if the trip doesn't exist and 502 Bad Gateway if it can't be got
+*/
+func getTripDetails(ctx context.Context, id int) (models.TripDetails, error) {
+	trip, err := tripClient.GetTrip(ctx, &trippb.Id{Id: int64(id)})
+	if rpc.HttpStatus(err) == http.StatusNotFound {
+		return models.TripDetails{}, service.NewError(http.StatusNotFound, rpc.Message(err))
+	}
+	if err != nil {
+		return models.TripDetails{}, backendError("trip", err)
+	}
+
+	return addTripDetails(ctx, []models.Trip{trip.ToModel()})[0], nil
+}
+
+/*
+This function gets a passenger's or driver's trips and adds who and what they were with.
+Every trip ever taken is too many to put together at once, so one of them is needed
+*/
+func listTripDetails(ctx context.Context, passengerId int, driverId int) ([]models.TripDetails, error) {
+	if passengerId == 0 && driverId == 0 {
+		return nil, service.NewError(http.StatusBadRequest, "passengerId or driverId is required")
+	}
+
+	trips, err := tripClient.ListTrips(ctx, &trippb.ListTripsRequest{PassengerId: int64(passengerId), DriverId: int64(driverId)})
+	if err != nil {
+		return nil, backendError("trips", err)
+	}
+
+	return addTripDetails(ctx, trips.ToModels()), nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+/*
+This function adds the names of the trips' passengers and drivers and their vehicles' plates.
+Each passenger, driver and vehicle is got once, all at the same time. Those that can't be got,
+e.g. because their microservice is down, are left empty with a warning so that the rest still are
+*/
+func addTripDetails(ctx context.Context, trips []models.Trip) []models.TripDetails {
+	parties := newTripParties()
+	for _, trip := range trips {
+		parties.fetch(ctx, "Passenger", trip.PassengerId)
+		parties.fetch(ctx, "Driver", trip.DriverId)
+		//trips booked before vehicles were recorded don't have one
+		if trip.VehicleId != 0 {
+			parties.fetch(ctx, "Vehicle", trip.VehicleId)
+		}
+	}
+	parties.wait.Wait()
+
+	allDetails := make([]models.TripDetails, len(trips))
+	for i, trip := range trips {
+		details := models.TripDetails{Trip: trip}
+
+		if passenger, ok := parties.found[partyKey("Passenger", trip.PassengerId)].(*passengerpb.Passenger); ok {
+			details.PassengerName = passenger.GetFirstName() + " " + passenger.GetLastName()
+		}
+		if driver, ok := parties.found[partyKey("Driver", trip.DriverId)].(*driverpb.Driver); ok {
+			details.DriverName = driver.GetFirstName() + " " + driver.GetLastName()
+			details.DriverMobileNo = int(driver.GetMobileNo())
+		}
+		if vehicle, ok := parties.found[partyKey("Vehicle", trip.VehicleId)].(*driverpb.Vehicle); ok {
+			details.VehiclePlate = vehicle.GetLicensePlate()
+			details.VehicleDescription = vehicle.GetColour() + " " + vehicle.GetMake() + " " + vehicle.GetModel()
+		}
+
+		for _, key := range []string{partyKey("Passenger", trip.PassengerId), partyKey("Driver", trip.DriverId), partyKey("Vehicle", trip.VehicleId)} {
+			if warning, ok := parties.warnings[key]; ok {
+				details.Warnings = append(details.Warnings, warning)
+			}
+		}
+		allDetails[i] = details
+	}
+	return allDetails
+}
+
+//The passengers, drivers and vehicles of some trips, by partyKey, or why they couldn't be got
+type tripParties struct {
+	wait     sync.WaitGroup
+	mutex    sync.Mutex
+	fetching map[string]bool
+	found    map[string]proto.Message
+	warnings map[string]string
+}
+
+func newTripParties() *tripParties {
+	return &tripParties{fetching: map[string]bool{}, found: map[string]proto.Message{}, warnings: map[string]string{}}
+}
+
+//Starts getting the passenger, driver or vehicle with id unless it's already being got
+func (parties *tripParties) fetch(ctx context.Context, what string, id int) {
+	key := partyKey(what, id)
+	if parties.fetching[key] {
+		return
+	}
+	parties.fetching[key] = true
+
+	parties.wait.Add(1)
+	go func() {
+		defer parties.wait.Done()
+
+		var found proto.Message
+		var err error
+		switch what {
+		case "Passenger":
+			found, err = passengerClient.GetPassenger(ctx, &passengerpb.Id{Id: int64(id)})
+		case "Driver":
+			found, err = driverClient.GetDriver(ctx, &driverpb.Id{Id: int64(id)})
+		case "Vehicle":
+			found, err = vehicleClient.GetVehicle(ctx, &driverpb.Id{Id: int64(id)})
+		}
+
+		parties.mutex.Lock()
+		defer parties.mutex.Unlock()
+		if err != nil {
+			logging.Error("Could not get trip details", map[string]interface{}{"requestId": logging.ContextRequestId(ctx), "what": key, "error": rpc.Message(err)})
+			parties.warnings[key] = what + " details are unavailable: " + unavailableReason(err)
+			return
+		}
+		parties.found[key] = found
+	}()
+}
+
+func partyKey(what string, id int) string {
+	return what + " " + strconv.Itoa(id)
+}
+
+//The console shows warnings to users, who can't do anything with how dialing failed
+func unavailableReason(err error) string {
+	if status.Code(err) == codes.Unavailable || status.Code(err) == codes.DeadlineExceeded {
+		return "its microservice couldn't be reached"
+	}
+	return rpc.Message(err)
+}
+
+func backendError(what string, err error) error {
+	return service.NewError(http.StatusBadGateway, "Could not get "+what+": "+rpc.Message(err))
+}
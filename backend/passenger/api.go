@@ -0,0 +1,90 @@
+package main
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"shared/models"
+	"shared/pii"
+)
+
+//Conversions between the models stored here and the models package's, which are what is sent and responded with.
+//Fields that can't be set by requests, like DeletedAt, are only converted to the models package's
+
+func (passenger Passenger) toApi() models.Passenger {
+	return models.Passenger{
+		Id:           passenger.Id,
+		FirstName:    passenger.FirstName,
+		LastName:     passenger.LastName,
+		MobileNo:     int(passenger.MobileNo),
+		Email:        string(passenger.Email),
+		Version:      passenger.Version,
+		DeletedAt:    deletedAtTime(passenger.DeletedAt),
+		AnonymisedAt: passenger.AnonymisedAt,
+	}
+}
+
+func passengersToApi(passengers []Passenger) []models.Passenger {
+	apiPassengers := make([]models.Passenger, len(passengers))
+	for i, passenger := range passengers {
+		apiPassengers[i] = passenger.toApi()
+	}
+	return apiPassengers
+}
+
+func passengerFromApi(passenger models.Passenger) Passenger {
+	return Passenger{
+		Id:        passenger.Id,
+		FirstName: passenger.FirstName,
+		LastName:  passenger.LastName,
+		MobileNo:  pii.EncryptedInt(passenger.MobileNo),
+		Email:     pii.EncryptedString(passenger.Email),
+		Version:   passenger.Version,
+	}
+}
+
+func (paymentMethod PaymentMethod) toApi() models.PaymentMethod {
+	return models.PaymentMethod{
+		Id:          paymentMethod.Id,
+		PassengerId: paymentMethod.PassengerId,
+		Last4:       paymentMethod.Last4,
+		ExpiryMonth: paymentMethod.ExpiryMonth,
+		ExpiryYear:  paymentMethod.ExpiryYear,
+		Token:       paymentMethod.Token,
+		CardNumber:  paymentMethod.CardNumber,
+	}
+}
+
+func paymentMethodsToApi(paymentMethods []PaymentMethod) []models.PaymentMethod {
+	apiPaymentMethods := make([]models.PaymentMethod, len(paymentMethods))
+	for i, paymentMethod := range paymentMethods {
+		apiPaymentMethods[i] = paymentMethod.toApi()
+	}
+	return apiPaymentMethods
+}
+
+func paymentMethodFromApi(paymentMethod models.PaymentMethod) PaymentMethod {
+	return PaymentMethod{
+		Id:          paymentMethod.Id,
+		PassengerId: paymentMethod.PassengerId,
+		Last4:       paymentMethod.Last4,
+		ExpiryMonth: paymentMethod.ExpiryMonth,
+		ExpiryYear:  paymentMethod.ExpiryYear,
+		Token:       paymentMethod.Token,
+		CardNumber:  paymentMethod.CardNumber,
+	}
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func deletedAtTime(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &deletedAt.Time
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+
+	"shared/proto/passengerpb"
+	"shared/proto/pbtime"
+	"shared/proto/trippb"
+	"shared/service"
+)
+
+//The gRPC server calls the same service functions as the HTTP functions, see shared/proto/passenger.proto.
+//Errors are turned into gRPC statuses by shared/rpc
+type passengerServer struct {
+	passengerpb.UnimplementedPassengerServiceServer
+}
+
+func (passengerServer) ListPassengers(ctx context.Context, req *passengerpb.ListPassengersRequest) (*passengerpb.Passengers, error) {
+	passengers, err := listPassengers(ctx, req.GetEmail(), req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+	return passengerpb.FromPassengers(passengersToApi(passengers)), nil
+}
+
+func (passengerServer) GetPassenger(ctx context.Context, req *passengerpb.Id) (*passengerpb.Passenger, error) {
+	passenger, err := getPassenger(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return passengerpb.FromPassenger(passenger.toApi()), nil
+}
+
+func (passengerServer) CreatePassenger(ctx context.Context, req *passengerpb.Passenger) (*passengerpb.Passenger, error) {
+	passenger, err := createPassenger(ctx, passengerFromApi(req.ToModel()))
+	if err != nil {
+		return nil, err
+	}
+	return passengerpb.FromPassenger(passenger.toApi()), nil
+}
+
+func (passengerServer) UpdatePassenger(ctx context.Context, req *passengerpb.Passenger) (*passengerpb.Passenger, error) {
+	ifMatch := service.IfMatchVersion(int(req.GetVersion()))
+	passenger, err := updatePassenger(ctx, int(req.GetId()), passengerFromApi(req.ToModel()), ifMatch)
+	if err != nil {
+		return nil, err
+	}
+	return passengerpb.FromPassenger(passenger.toApi()), nil
+}
+
+func (passengerServer) DeletePassenger(ctx context.Context, req *passengerpb.VersionedId) (*passengerpb.Result, error) {
+	message, err := deletePassenger(ctx, int(req.GetId()), service.IfMatchVersion(int(req.GetVersion())))
+	if err != nil {
+		return nil, err
+	}
+	return &passengerpb.Result{Message: message}, nil
+}
+
+func (passengerServer) RestorePassenger(ctx context.Context, req *passengerpb.Id) (*passengerpb.Result, error) {
+	message, err := restorePassenger(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &passengerpb.Result{Message: message}, nil
+}
+
+func (passengerServer) ExportPassenger(ctx context.Context, req *passengerpb.Id) (*passengerpb.PassengerExport, error) {
+	export, err := exportPassenger(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &passengerpb.PassengerExport{
+		Passenger:      passengerpb.FromPassenger(export.Passenger.toApi()),
+		PaymentMethods: passengerpb.FromPaymentMethods(paymentMethodsToApi(export.PaymentMethods)).GetPaymentMethods(),
+		Trips:          trippb.FromTrips(export.Trips).GetTrips(),
+		ExportedAt:     pbtime.RequiredTimestamp(export.ExportedAt),
+	}, nil
+}
+
+func (passengerServer) AnonymisePassenger(ctx context.Context, req *passengerpb.Id) (*passengerpb.Result, error) {
+	message, err := anonymisePassenger(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &passengerpb.Result{Message: message}, nil
+}
+
+func (passengerServer) ListPaymentMethods(ctx context.Context, req *passengerpb.Id) (*passengerpb.PaymentMethods, error) {
+	paymentMethods := listPaymentMethods(ctx, int(req.GetId()))
+	return passengerpb.FromPaymentMethods(paymentMethodsToApi(paymentMethods)), nil
+}
+
+func (passengerServer) CreatePaymentMethod(ctx context.Context, req *passengerpb.PaymentMethod) (*passengerpb.PaymentMethod, error) {
+	paymentMethod, err := createPaymentMethod(ctx, int(req.GetPassengerId()), paymentMethodFromApi(req.ToModel()))
+	if err != nil {
+		return nil, err
+	}
+	return passengerpb.FromPaymentMethod(paymentMethod.toApi()), nil
+}
+
+func (passengerServer) DeletePaymentMethod(ctx context.Context, req *passengerpb.PaymentMethodId) (*passengerpb.Result, error) {
+	message, err := deletePaymentMethod(ctx, int(req.GetPassengerId()), int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &passengerpb.Result{Message: message}, nil
+}
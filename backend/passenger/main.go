@@ -1,17 +1,29 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
-	"os"
-	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
-	"github.com/joho/godotenv"
-	"gorm.io/driver/mysql"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	"gorm.io/gorm"
+
+	"shared/database"
+	"shared/httputil"
+	"shared/logging"
+	"shared/metrics"
+	"shared/models"
+	"shared/openapi"
+	"shared/pii"
+	"shared/proto/passengerpb"
+	"shared/rpc"
+	"shared/server"
+	"shared/service"
+	"shared/tracing"
 )
 
 //Note: Field names have to be capitalised to be public to work with Gorm
@@ -20,62 +32,67 @@ type Passenger struct {
 	Id        int `gorm:"primaryKey"`
 	FirstName string
 	LastName  string
-	MobileNo  int
-	Email     string
+	MobileNo  pii.EncryptedInt
+	Email     pii.EncryptedString
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	Version   int            `gorm:"not null;default:1"` //bumped on every change, sent as the ETag
+
+	EmailIndex string `gorm:"uniqueIndex:idx_passengers_email_unique;size:64" json:"-"` //blind index for looking up Email
+
+	AnonymisedAt *time.Time //personal data was scrubbed on request
 }
 
+const serviceName = "passenger"
+
 //Global Variables
 var db *gorm.DB
 
 func main() {
-	loadEnv()
-	initDb()
-	migrateDb()
-	initRouter()
-}
-
-func loadEnv() {
-	err := godotenv.Load("../.env")
-	if err != nil {
-		log.Fatal("Error loading .env file")
+	args := loadConfig()
+	logging.Init(serviceName)
+	tracing.Init(serviceName, config.Service)
+	pii.Init(config.PiiKeys, config.PiiIndexKey)
+	db = database.Open(config.Dsn)
+
+	if len(args) > 0 && args[0] == "migrate" {
+		database.RunMigrateCommand(db, serviceName, args[1:])
+		return
 	}
-}
-
-func initDb() {
-	dsn := os.Getenv("DSN")
-
-	var err error
 
-	//set global var "db"
-	db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
-	if err != nil {
-		panic("Failed to connect to database with DSN: " + dsn)
-	}
+	latestSchemaVersion := database.CheckSchemaVersion(db, serviceName)
+	pii.Reencrypt(db, &Passenger{})
+	initTripClient()
+	startPurgeMonitor()
+	initRouter(latestSchemaVersion)
 }
 
-func migrateDb() {
-	err := db.AutoMigrate(&Passenger{})
-	if err != nil {
-		panic("DB Migration failed with error: " + err.Error())
-	}
-}
-
-func initRouter() {
+func initRouter(latestSchemaVersion int) {
 	router := mux.NewRouter()
 
-	router.HandleFunc("/passengers", getPassengers).Methods("GET")
-	router.HandleFunc("/passengers/{id}", getPassengerById).Methods("GET")
-	router.HandleFunc("/passengers", createPassenger).Methods("POST")
-	router.HandleFunc("/passengers/{id}", updatePassenger).Methods("PUT")
-	router.HandleFunc("/passengers/{id}", deletePassenger).Methods("DELETE")
-
-	portNo := os.Getenv("PASSENGER_PORT")
-
-	fmt.Printf("Passenger Microservice running on port %s...\n", portNo)
-	err := http.ListenAndServe(":"+portNo, router)
-	if err != nil {
-		panic("InitRouter failed with error: " + err.Error())
-	}
+	router.HandleFunc("/healthz", server.GetHealth).Methods("GET")
+	router.HandleFunc("/readyz", server.GetReadiness(db, serviceName, latestSchemaVersion)).Methods("GET")
+	router.Use(otelmux.Middleware(serviceName))
+	router.Use(logging.Middleware)
+	router.Use(httputil.AdminMiddleware(config.AdminPassword))
+	metrics.Init(router, db, passengersGauge)
+	openapi.Init(router, "openapi.yaml")
+
+	router.HandleFunc("/passengers", httpGetPassengers).Methods("GET")
+	router.HandleFunc("/passengers/{id}", httpGetPassengerById).Methods("GET")
+	router.HandleFunc("/passengers", httpCreatePassenger).Methods("POST")
+	router.HandleFunc("/passengers/{id}", httpUpdatePassenger).Methods("PUT")
+	router.HandleFunc("/passengers/{id}", httpDeletePassenger).Methods("DELETE")
+	router.HandleFunc("/passengers/{id}/restore", httpRestorePassenger).Methods("POST")
+	router.HandleFunc("/passengers/{id}/export", httpExportPassenger).Methods("GET")
+	router.HandleFunc("/passengers/{id}/anonymise", httpAnonymisePassenger).Methods("POST")
+	router.HandleFunc("/passengers/{id}/paymentMethods", httpGetPaymentMethods).Methods("GET")
+	router.HandleFunc("/passengers/{id}/paymentMethods", httpCreatePaymentMethod).Methods("POST")
+	router.HandleFunc("/passengers/{id}/paymentMethods/{methodId}", httpDeletePaymentMethod).Methods("DELETE")
+
+	grpcServer := rpc.NewServer(config.AdminPassword)
+	passengerpb.RegisterPassengerServiceServer(grpcServer, passengerServer{})
+
+	server.Start("Passenger", router, grpcServer, config.Service, db)
 }
 
 /////////////////////////
@@ -84,167 +101,212 @@ func initRouter() {
 //                     //
 /////////////////////////
 
-func getPassengers(w http.ResponseWriter, r *http.Request) {
-	var passengers []Passenger
-
+func httpGetPassengers(w http.ResponseWriter, r *http.Request) {
 	urlParams := r.URL.Query()
-	if queryEmail, ok := urlParams["email"]; ok {
-		email := queryEmail[0]
-		db.Where("email = ?", email).Find(&passengers)
-	} else {
-		db.Find(&passengers)
+	includeDeleted, _ := strconv.ParseBool(urlParams.Get("includeDeleted"))
+
+	passengers, err := listPassengers(r.Context(), urlParams.Get("email"), includeDeleted)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
 	}
 
-	httpRespondWith(w, http.StatusOK, passengers)
+	httputil.RespondWith(w, http.StatusOK, passengersToApi(passengers))
 }
 
-func getPassengerById(w http.ResponseWriter, r *http.Request) {
+func httpGetPassengerById(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
-	id := params["id"]
+	id, _ := strconv.Atoi(params["id"])
 
-	var passenger Passenger
-	err := db.Where("id = ?", id).First(&passenger).Error
+	passenger, err := getPassenger(r.Context(), id)
 	if err != nil {
-		httpRespondWith(w, http.StatusNotFound, "User doesn't exist")
+		httputil.RespondWithError(w, err)
 		return
 	}
 
-	httpRespondWith(w, http.StatusOK, passenger)
+	httputil.SetETag(w, passenger.Version)
+	httputil.RespondWith(w, http.StatusOK, passenger.toApi())
 }
 
-func createPassenger(w http.ResponseWriter, r *http.Request) {
-	var passenger Passenger
+func httpCreatePassenger(w http.ResponseWriter, r *http.Request) {
+	var body models.Passenger
 
-	decodeErr := json.NewDecoder(r.Body).Decode(&passenger)
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
 	if decodeErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
-		return
-	}
-
-	//validate empty fields
-	if isFieldMissing(w, passenger.FirstName, "FirstName") ||
-		isFieldMissing(w, passenger.LastName, "LastName") ||
-		isFieldMissing(w, passenger.MobileNo, "MobileNo") ||
-		isFieldMissing(w, passenger.Email, "Email") {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
 		return
 	}
 
-	//validate email exist
-	emailExist := existInDb("email", passenger.Email)
-	if emailExist {
-		httpRespondWith(w, http.StatusConflict, "Email already in-use.")
-		return
-	}
-
-	//Disallow manual setting of Id
-	passenger.Id = 0
-
-	dbErr := db.Create(&passenger).Error
-	if dbErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid Data")
+	passenger, err := createPassenger(r.Context(), passengerFromApi(body))
+	if err != nil {
+		httputil.RespondWithError(w, err)
 		return
 	}
 
-	httpRespondWith(w, http.StatusCreated, passenger)
+	httputil.SetETag(w, passenger.Version)
+	httputil.RespondWith(w, http.StatusCreated, passenger.toApi())
 }
 
-func updatePassenger(w http.ResponseWriter, r *http.Request) {
-	var passenger Passenger
+func httpUpdatePassenger(w http.ResponseWriter, r *http.Request) {
+	var body models.Passenger
 
-	decodeErr := json.NewDecoder(r.Body).Decode(&passenger)
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
 	if decodeErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
 		return
 	}
 
-	//Disallow manual setting of Id
-	passenger.Id = 0
-
 	params := mux.Vars(r)
-	id := params["id"]
+	id, _ := strconv.Atoi(params["id"])
 
-	dbErr := db.Model(&Passenger{}).Where("id = ?", id).Updates(passenger).Error
-	if dbErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid Data")
+	passenger, err := updatePassenger(r.Context(), id, passengerFromApi(body), httputil.IfMatch(r))
+	if err != nil {
+		httputil.RespondWithError(w, err)
 		return
 	}
 
-	var newPassenger Passenger
-	db.Where("id = ?", id).First(&newPassenger)
-
-	httpRespondWith(w, http.StatusAccepted, newPassenger)
+	httputil.SetETag(w, passenger.Version)
+	httputil.RespondWith(w, http.StatusAccepted, passenger.toApi())
 }
 
-func deletePassenger(w http.ResponseWriter, r *http.Request) {
-	//check admin password
-	if !hasValidAdminPass(r) {
-		httpRespondWith(w, http.StatusForbidden, "Unauthorized User")
-		return
-	}
-
+func httpDeletePassenger(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
-	id := params["id"]
+	id, _ := strconv.Atoi(params["id"])
 
-	//check user exist
-	idExist := existInDb("id", id)
-	if !idExist {
-		httpRespondWith(w, http.StatusNotFound, "User doesn't exist")
+	message, err := deletePassenger(r.Context(), id, httputil.IfMatch(r))
+	if err != nil {
+		httputil.RespondWithError(w, err)
 		return
 	}
 
-	//delete from passenger where id = id
-	db.Delete(&Passenger{}, id)
-
-	httpRespondWith(w, http.StatusAccepted, fmt.Sprintf("User of ID %s successfully deleted", id))
+	httputil.RespondWith(w, http.StatusAccepted, message)
 }
 
 /////////////////////////
 //                     //
-//       Helpers       //
+//  Service Functions  //
 //                     //
 /////////////////////////
 
-func httpRespondWith(w http.ResponseWriter, statusCode int, data interface{}) {
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(data)
+//Lists the passengers with the email, or all of them if it's empty
+func listPassengers(ctx context.Context, email string, includeDeleted bool) ([]Passenger, error) {
+	var passengers []Passenger
+
+	query, err := database.ListQuery(ctx, db, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	if email != "" {
+		query.Where("email_index = ?", pii.EmailIndex(email)).Find(&passengers)
+	} else {
+		query.Find(&passengers)
+	}
+	return passengers, nil
 }
 
-/*
-This function checks whether a data has a zero value.
-If it does, it will return true and write a http response
-*/
-func isFieldMissing(w http.ResponseWriter, data interface{}, fieldName string) bool {
-	if isZero(data) {
-		errorMsg := fmt.Sprintf("%s field is missing/incorrect.", fieldName)
-		httpRespondWith(w, http.StatusBadRequest, errorMsg)
-		return true
-	}
-	return false
+func getPassenger(ctx context.Context, id int) (Passenger, error) {
+	db := db.WithContext(ctx)
+
+	var passenger Passenger
+	err := db.Where("id = ?", id).First(&passenger).Error
+	if err != nil {
+		return passenger, service.NewError(http.StatusNotFound, "User doesn't exist")
+	}
+	return passenger, nil
 }
 
-func isZero(data interface{}) bool {
-	value := reflect.ValueOf(data)
-	return value.IsZero()
+func createPassenger(ctx context.Context, passenger Passenger) (Passenger, error) {
+	db := db.WithContext(ctx)
+
+	//validate empty fields
+	err := service.FirstError(
+		service.RequireField(passenger.FirstName, "FirstName"),
+		service.RequireField(passenger.LastName, "LastName"),
+		service.RequireField(passenger.MobileNo, "MobileNo"),
+		service.RequireField(passenger.Email, "Email"),
+	)
+	if err != nil {
+		return passenger, err
+	}
+
+	//validate email exist, emails of deleted passengers stay taken until they are purged
+	emailExist := database.ExistInDb(db, &Passenger{}, "email_index", pii.EmailIndex(string(passenger.Email)))
+	if emailExist {
+		return passenger, service.NewError(http.StatusConflict, "Email already in-use.")
+	}
+
+	//Disallow manual setting of Id and Version
+	passenger.Id = 0
+	passenger.Version = 1
+	passenger.EmailIndex = pii.EmailIndex(string(passenger.Email))
+
+	dbErr := db.Create(&passenger).Error
+	if database.IsDuplicateKeyError(dbErr) {
+		return passenger, service.NewError(http.StatusConflict, "Email already in-use.")
+	}
+	if dbErr != nil {
+		return passenger, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	return passenger, nil
 }
 
-/*
-This function returns whether a row with the given value for the given fieldName exists
-*/
-func existInDb(fieldName string, value interface{}) bool {
-	var dbPassenger Passenger
+func updatePassenger(ctx context.Context, id int, passenger Passenger, ifMatch service.IfMatch) (Passenger, error) {
+	db := db.WithContext(ctx)
+
+	oldPassenger, err := getPassenger(ctx, id)
+	if err != nil {
+		return oldPassenger, err
+	}
+	err = ifMatch.Check(oldPassenger.Version)
+	if err != nil {
+		return oldPassenger, err
+	}
+
+	//Disallow manual setting of Id and Version
+	passenger.Id = 0
+	passenger.Version = oldPassenger.Version + 1
+	if passenger.Email != "" {
+		passenger.EmailIndex = pii.EmailIndex(string(passenger.Email))
+	}
+
+	result := db.Model(&Passenger{}).Where("id = ? AND version = ?", id, oldPassenger.Version).Updates(passenger)
+	if database.IsDuplicateKeyError(result.Error) {
+		return oldPassenger, service.NewError(http.StatusConflict, "Email already in-use.")
+	}
+	if result.Error != nil {
+		return oldPassenger, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	if result.RowsAffected == 0 {
+		return oldPassenger, service.VersionChanged()
+	}
 
-	//if email doens't exist, db.First returns a ErrRecordNotFound
-	err := db.Where(fieldName+" = ?", value).First(&dbPassenger).Error
-	return err != gorm.ErrRecordNotFound
+	var newPassenger Passenger
+	db.Where("id = ?", id).First(&newPassenger)
+	return newPassenger, nil
 }
 
-func hasValidAdminPass(r *http.Request) bool {
-	urlParams := r.URL.Query()
-	if queryPassword, ok := urlParams["adminPassword"]; ok {
-		password := os.Getenv("ADMIN_PASSWORD")
-		if queryPassword[0] == password {
-			return true
-		}
+func deletePassenger(ctx context.Context, id int, ifMatch service.IfMatch) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
 	}
-	return false
+
+	passenger, err := getPassenger(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	err = ifMatch.Check(passenger.Version)
+	if err != nil {
+		return "", err
+	}
+
+	//soft delete so that the passenger can be restored until purged
+	result := db.Where("version = ?", passenger.Version).Delete(&passenger)
+	if result.RowsAffected == 0 {
+		return "", service.VersionChanged()
+	}
+
+	return fmt.Sprintf("User of ID %d successfully deleted", id), nil
 }
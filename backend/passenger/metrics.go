@@ -0,0 +1,15 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//Counted when scraped, so that they are right whichever instance made the change
+var passengersGauge = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+	Name: "hytchhyke_passengers",
+	Help: "Passengers that aren't deleted",
+}, func() float64 {
+	var count int64
+	db.Model(&Passenger{}).Count(&count)
+	return float64(count)
+})
@@ -0,0 +1,9 @@
+-- The table as the first release's AutoMigrate made it, IF NOT EXISTS lets its databases be adopted
+CREATE TABLE IF NOT EXISTS passengers (
+    id bigint NOT NULL AUTO_INCREMENT,
+    first_name longtext,
+    last_name longtext,
+    mobile_no bigint,
+    email longtext,
+    PRIMARY KEY (id)
+);
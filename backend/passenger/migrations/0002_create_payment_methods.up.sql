@@ -0,0 +1,9 @@
+CREATE TABLE payment_methods (
+    id bigint NOT NULL AUTO_INCREMENT,
+    passenger_id bigint,
+    last4 longtext,
+    expiry_month bigint,
+    expiry_year bigint,
+    token longtext,
+    PRIMARY KEY (id)
+);
@@ -0,0 +1,2 @@
+DROP INDEX idx_passengers_deleted_at ON passengers;
+ALTER TABLE passengers DROP COLUMN deleted_at;
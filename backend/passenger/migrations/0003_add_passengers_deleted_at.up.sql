@@ -0,0 +1,2 @@
+ALTER TABLE passengers ADD COLUMN deleted_at datetime(3) NULL;
+CREATE INDEX idx_passengers_deleted_at ON passengers (deleted_at);
@@ -0,0 +1 @@
+ALTER TABLE passengers DROP COLUMN anonymised_at;
@@ -0,0 +1 @@
+ALTER TABLE passengers ADD COLUMN anonymised_at datetime(3) NULL;
@@ -0,0 +1,3 @@
+-- Only works once mobile numbers are decrypted, encrypted ones don't fit in a bigint
+ALTER TABLE passengers DROP COLUMN email_index;
+ALTER TABLE passengers MODIFY mobile_no bigint;
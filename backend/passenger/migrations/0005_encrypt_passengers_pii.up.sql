@@ -0,0 +1,3 @@
+-- Encrypted mobile numbers are text, existing rows are encrypted and indexed when the microservice starts
+ALTER TABLE passengers MODIFY mobile_no longtext;
+ALTER TABLE passengers ADD COLUMN email_index varchar(64);
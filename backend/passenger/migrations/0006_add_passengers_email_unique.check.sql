@@ -0,0 +1,13 @@
+-- Passengers with the same email, written before emails had to be unique, all but one of each must be changed.
+-- Plaintext ones are compared as they would be indexed once encrypted and encrypted ones by their blind index
+SELECT LOWER(TRIM(email)) AS email, GROUP_CONCAT(id ORDER BY id) AS passenger_ids
+FROM passengers
+WHERE email NOT LIKE 'pii:%'
+GROUP BY LOWER(TRIM(email))
+HAVING COUNT(*) > 1;
+
+SELECT email_index, GROUP_CONCAT(id ORDER BY id) AS passenger_ids
+FROM passengers
+WHERE email_index IS NOT NULL
+GROUP BY email_index
+HAVING COUNT(*) > 1;
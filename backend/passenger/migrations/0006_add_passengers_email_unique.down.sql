@@ -0,0 +1 @@
+DROP INDEX idx_passengers_email_unique ON passengers;
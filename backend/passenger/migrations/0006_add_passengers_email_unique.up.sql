@@ -0,0 +1 @@
+CREATE UNIQUE INDEX idx_passengers_email_unique ON passengers (email_index);
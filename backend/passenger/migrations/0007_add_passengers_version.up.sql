@@ -0,0 +1 @@
+ALTER TABLE passengers ADD COLUMN version bigint NOT NULL DEFAULT 1;
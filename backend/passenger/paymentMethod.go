@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"shared/database"
+	"shared/httputil"
+	"shared/models"
+	"shared/service"
+)
+
+//Card details are never stored, only a token that the payment provider can charge
+type PaymentMethod struct {
+	Id          int `gorm:"primaryKey"`
+	PassengerId int
+	Last4       string
+	ExpiryMonth int
+	ExpiryYear  int
+	Token       string
+
+	//Only accepted on creation
+	CardNumber string `gorm:"-" json:",omitempty"`
+}
+
+//Tokens issued here are understood by the trip service's local payment provider
+const localTokenPrefix = "tok_local_"
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpGetPaymentMethods(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	paymentMethods := listPaymentMethods(r.Context(), id)
+
+	httputil.RespondWith(w, http.StatusOK, paymentMethodsToApi(paymentMethods))
+}
+
+func httpCreatePaymentMethod(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	var body models.PaymentMethod
+
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
+
+	paymentMethod, err := createPaymentMethod(r.Context(), id, paymentMethodFromApi(body))
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusCreated, paymentMethod.toApi())
+}
+
+func httpDeletePaymentMethod(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+	methodId, _ := strconv.Atoi(params["methodId"])
+
+	message, err := deletePaymentMethod(r.Context(), id, methodId)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+func listPaymentMethods(ctx context.Context, passengerId int) []PaymentMethod {
+	db := db.WithContext(ctx)
+
+	var paymentMethods []PaymentMethod
+	db.Where("passenger_id = ?", passengerId).Find(&paymentMethods)
+	return paymentMethods
+}
+
+func createPaymentMethod(ctx context.Context, passengerId int, paymentMethod PaymentMethod) (PaymentMethod, error) {
+	db := db.WithContext(ctx)
+
+	//check user exist
+	idExist := database.ExistInDb(db, &Passenger{}, "id", passengerId)
+	if !idExist {
+		return paymentMethod, service.NewError(http.StatusNotFound, "User doesn't exist")
+	}
+
+	//validate empty fields
+	err := service.FirstError(
+		service.RequireField(paymentMethod.CardNumber, "CardNumber"),
+		service.RequireField(paymentMethod.ExpiryMonth, "ExpiryMonth"),
+		service.RequireField(paymentMethod.ExpiryYear, "ExpiryYear"),
+	)
+	if err != nil {
+		return paymentMethod, err
+	}
+
+	if !isValidCardNumber(paymentMethod.CardNumber) {
+		return paymentMethod, service.NewError(http.StatusBadRequest, "Invalid card number.")
+	}
+	if isCardExpired(paymentMethod.ExpiryMonth, paymentMethod.ExpiryYear) {
+		return paymentMethod, service.NewError(http.StatusBadRequest, "Card has expired.")
+	}
+
+	token, tokenErr := newPaymentToken()
+	if tokenErr != nil {
+		return paymentMethod, service.NewError(http.StatusInternalServerError, "Could not tokenise card")
+	}
+
+	//Disallow manual setting of Id and Token
+	paymentMethod.Id = 0
+	paymentMethod.PassengerId = passengerId
+	paymentMethod.Token = token
+	paymentMethod.Last4 = paymentMethod.CardNumber[len(paymentMethod.CardNumber)-4:]
+	paymentMethod.CardNumber = ""
+
+	dbErr := db.Create(&paymentMethod).Error
+	if dbErr != nil {
+		return paymentMethod, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	return paymentMethod, nil
+}
+
+func deletePaymentMethod(ctx context.Context, passengerId int, id int) (string, error) {
+	db := db.WithContext(ctx)
+
+	result := db.Where("id = ? AND passenger_id = ?", id, passengerId).Delete(&PaymentMethod{})
+	if result.RowsAffected == 0 {
+		return "", service.NewError(http.StatusNotFound, "Payment method doesn't exist")
+	}
+
+	return fmt.Sprintf("Payment method of ID %d successfully deleted", id), nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+/*
+This function validates a card number with the Luhn checksum
+*/
+func isValidCardNumber(cardNumber string) bool {
+	if len(cardNumber) < 12 || len(cardNumber) > 19 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(cardNumber) - 1; i >= 0; i-- {
+		digit := int(cardNumber[i] - '0')
+		if digit < 0 || digit > 9 {
+			return false
+		}
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func isCardExpired(month int, year int) bool {
+	if month < 1 || month > 12 {
+		return true
+	}
+	//cards are valid until the end of their expiry month
+	expiry := time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, time.UTC)
+	return time.Now().After(expiry)
+}
+
+func newPaymentToken() (string, error) {
+	randomBytes := make([]byte, 12)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+	return localTokenPrefix + hex.EncodeToString(randomBytes), nil
+}
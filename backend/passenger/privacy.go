@@ -0,0 +1,225 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"shared/httputil"
+	"shared/logging"
+	"shared/models"
+	"shared/pii"
+	"shared/proto/trippb"
+	"shared/rpc"
+	"shared/service"
+)
+
+//Everything held about a passenger, for data subject access requests
+type PassengerExport struct {
+	Passenger      Passenger
+	PaymentMethods []PaymentMethod
+	Trips          []models.Trip //as returned by the trip service
+	ExportedAt     time.Time
+}
+
+var tripClient trippb.TripServiceClient
+
+//Passengers' trips are exported from the trip microservice
+func initTripClient() {
+	conn, err := rpc.Dial(config.TripGrpcAddress)
+	if err != nil {
+		log.Fatal("Connecting to the trip microservice failed: " + err.Error())
+	}
+	tripClient = trippb.NewTripServiceClient(conn)
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+/*
+Exports the passenger's profile, payment methods and trips as JSON,
+or as a ZIP with one file for each when format=zip
+*/
+func httpExportPassenger(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	export, err := exportPassenger(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	if r.URL.Query().Get("format") != "zip" {
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=passenger-%d.json", export.Passenger.Id))
+		httputil.RespondWith(w, http.StatusOK, export)
+		return
+	}
+
+	archive, err := zipPassengerExport(export)
+	if err != nil {
+		logging.Error("Export could not be archived", map[string]interface{}{"requestId": logging.RequestId(r), "error": err.Error()})
+		httputil.RespondWithError(w, service.NewError(http.StatusInternalServerError, "Export could not be archived"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=passenger-%d.zip", export.Passenger.Id))
+	w.WriteHeader(http.StatusOK)
+	w.Write(archive)
+}
+
+func httpAnonymisePassenger(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	message, err := anonymisePassenger(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+func exportPassenger(ctx context.Context, id int) (PassengerExport, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return PassengerExport{}, err
+	}
+
+	var passenger Passenger
+	err = db.Unscoped().Where("id = ?", id).First(&passenger).Error
+	if err != nil {
+		return PassengerExport{}, service.NewError(http.StatusNotFound, "User doesn't exist")
+	}
+
+	var paymentMethods []PaymentMethod
+	db.Where("passenger_id = ?", passenger.Id).Find(&paymentMethods)
+	for i := range paymentMethods {
+		//tokens can be charged, so they don't leave the service
+		paymentMethods[i].Token = ""
+	}
+
+	trips, tripsErr := getPassengerTrips(ctx, passenger.Id)
+	if tripsErr != nil {
+		return PassengerExport{}, service.NewError(http.StatusBadGateway, "Could not get trips: "+rpc.Message(tripsErr))
+	}
+
+	export := PassengerExport{
+		Passenger:      passenger,
+		PaymentMethods: paymentMethods,
+		Trips:          trips,
+		ExportedAt:     time.Now(),
+	}
+	return export, nil
+}
+
+/*
+Scrubs the passenger's personal data but keeps the passenger's Id,
+so that their trips and payments still add up
+*/
+func anonymisePassenger(ctx context.Context, id int) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var passenger Passenger
+	err = db.Unscoped().Where("id = ?", id).First(&passenger).Error
+	if err != nil {
+		return "", service.NewError(http.StatusNotFound, "User doesn't exist")
+	}
+	if passenger.AnonymisedAt != nil {
+		return "", service.NewError(http.StatusConflict, "User is already anonymised")
+	}
+
+	db.Where("passenger_id = ?", passenger.Id).Delete(&PaymentMethod{})
+	email := fmt.Sprintf("anonymised-%d@invalid", passenger.Id)
+	db.Unscoped().Model(&passenger).Updates(map[string]interface{}{
+		"FirstName":    "Anonymised",
+		"LastName":     "Passenger",
+		"MobileNo":     pii.EncryptedInt(0),
+		"Email":        pii.EncryptedString(email),
+		"EmailIndex":   pii.EmailIndex(email),
+		"AnonymisedAt": time.Now(),
+	})
+
+	return fmt.Sprintf("User of ID %d successfully anonymised", passenger.Id), nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+//Deleted trips are included as they are held until purged, which needs the admin password
+func getPassengerTrips(ctx context.Context, passengerId int) ([]models.Trip, error) {
+	ctx = rpc.WithAdminPassword(ctx, config.AdminPassword)
+	trips, err := tripClient.ListTrips(ctx, &trippb.ListTripsRequest{PassengerId: int64(passengerId), IncludeDeleted: true})
+	if err != nil {
+		return nil, err
+	}
+	return trips.ToModels(), nil
+}
+
+/*
+This function returns the export as a ZIP with one JSON file for each part.
+It is built before responding so that a part that can't be written fails the request rather than cutting off the ZIP
+*/
+func zipPassengerExport(export PassengerExport) ([]byte, error) {
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"passenger.json", export.Passenger},
+		{"paymentMethods.json", export.PaymentMethods},
+		{"trips.json", export.Trips},
+	}
+	for _, file := range files {
+		err := writeZipJson(archive, file.name, file.data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := archive.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func writeZipJson(archive *zip.Writer, name string, data interface{}) error {
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
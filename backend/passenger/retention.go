@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"shared/httputil"
+	"shared/server"
+	"shared/service"
+)
+
+const purgeInterval = time.Hour
+
+/*
+This function hard deletes passengers that were deleted more than
+DELETED_RETENTION_DAYS ago, along with their payment methods
+*/
+func startPurgeMonitor() {
+	retention := time.Duration(config.DeletedRetentionDays) * 24 * time.Hour
+
+	purgeDeletedPassengers(retention)
+
+	server.RunEvery(purgeInterval, func() {
+		purgeDeletedPassengers(retention)
+	})
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpRestorePassenger(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	message, err := restorePassenger(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+func restorePassenger(ctx context.Context, id int) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var passenger Passenger
+	err = db.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&passenger).Error
+	if err != nil {
+		return "", service.NewError(http.StatusNotFound, "Deleted user doesn't exist")
+	}
+
+	db.Unscoped().Model(&passenger).Update("deleted_at", nil)
+
+	return fmt.Sprintf("User of ID %d successfully restored", id), nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func purgeDeletedPassengers(retention time.Duration) {
+	var passengers []Passenger
+	db.Unscoped().Where("deleted_at < ?", time.Now().Add(-retention)).Find(&passengers)
+
+	for _, passenger := range passengers {
+		db.Where("passenger_id = ?", passenger.Id).Delete(&PaymentMethod{})
+		db.Unscoped().Delete(&passenger)
+	}
+}
@@ -3,21 +3,28 @@ echo "Starting Microservices..."
 run_passenger() {
     cd passenger
     go mod tidy
-    go run main.go
+    go run . migrate up && go run .
 }
 
 run_driver() {
     cd driver
     go mod tidy
-    go run main.go
+    go run . migrate up && go run .
 }
 
 run_trip() {
     cd trip
     go mod tidy
-    go run main.go
+    go run . migrate up && go run .
+}
+
+run_gateway() {
+    cd gateway
+    go mod tidy
+    go run .
 }
 
 run_passenger & 
 run_driver & 
-run_trip
+run_trip &
+run_gateway
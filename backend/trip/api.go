@@ -0,0 +1,85 @@
+package main
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"shared/models"
+)
+
+//Conversions between the models stored here and the models package's, which are what is sent and responded with.
+//Fields that can't be set by requests, like CreatedAt, are only converted to the models package's
+
+func (trip Trip) toApi() models.Trip {
+	return models.Trip{
+		Id:                 trip.Id,
+		PassengerId:        trip.PassengerId,
+		DriverId:           trip.DriverId,
+		VehicleId:          trip.VehicleId,
+		PickUpPostal:       trip.PickUpPostal,
+		DropOffPostal:      trip.DropOffPostal,
+		RideClass:          trip.RideClass,
+		PartySize:          trip.PartySize,
+		Fare:               trip.Fare,
+		Discount:           trip.Discount,
+		PromoCode:          trip.PromoCode,
+		SurgeMultiplier:    trip.SurgeMultiplier,
+		Status:             trip.Status,
+		CreatedAt:          trip.CreatedAt,
+		Version:            trip.Version,
+		DeletedAt:          deletedAtTime(trip.DeletedAt),
+		PaymentMethodToken: trip.PaymentMethodToken,
+	}
+}
+
+func tripsToApi(trips []Trip) []models.Trip {
+	apiTrips := make([]models.Trip, len(trips))
+	for i, trip := range trips {
+		apiTrips[i] = trip.toApi()
+	}
+	return apiTrips
+}
+
+func tripFromApi(trip models.Trip) Trip {
+	return Trip{
+		Id:                 trip.Id,
+		PassengerId:        trip.PassengerId,
+		DriverId:           trip.DriverId,
+		VehicleId:          trip.VehicleId,
+		PickUpPostal:       trip.PickUpPostal,
+		DropOffPostal:      trip.DropOffPostal,
+		RideClass:          trip.RideClass,
+		PartySize:          trip.PartySize,
+		Fare:               trip.Fare,
+		Discount:           trip.Discount,
+		PromoCode:          trip.PromoCode,
+		SurgeMultiplier:    trip.SurgeMultiplier,
+		Status:             trip.Status,
+		Version:            trip.Version,
+		PaymentMethodToken: trip.PaymentMethodToken,
+	}
+}
+
+func (quote FareQuote) toApi() models.FareQuote {
+	return models.FareQuote{
+		SurgeMultiplier: quote.SurgeMultiplier,
+		FullFare:        quote.FullFare,
+		Discount:        quote.Discount,
+		Fare:            quote.Fare,
+		PromoCode:       quote.PromoCode,
+	}
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func deletedAtTime(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	return &deletedAt.Time
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"shared/settings"
+)
+
+//The settings every microservice has are in settings.Service, see shared/settings for how they're loaded
+type Config struct {
+	settings.Service
+	PaymentProvider      string
+	PassengerGrpcAddress string
+	DriverGrpcAddress    string
+}
+
+var config Config
+
+const defaultConfigFile = "../.env"
+
+func defaultConfig() Config {
+	return Config{
+		Service:              settings.DefaultService(5002),
+		PaymentProvider:      "local",
+		PassengerGrpcAddress: "localhost:6000",
+		DriverGrpcAddress:    "localhost:6001",
+	}
+}
+
+func configSettings() []*settings.Setting {
+	return append(config.Service.Settings("TRIP_PORT", "trips"),
+		&settings.Setting{Name: "PAYMENT_PROVIDER", Value: &config.PaymentProvider, Usage: "who takes payments, only \"local\" for now"},
+		&settings.Setting{Name: "PASSENGER_GRPC_ADDRESS", Value: &config.PassengerGrpcAddress, Usage: "host:port of the passenger microservice's gRPC server"},
+		&settings.Setting{Name: "DRIVER_GRPC_ADDRESS", Value: &config.DriverGrpcAddress, Usage: "host:port of the driver microservice's gRPC server"},
+	)
+}
+
+//Returns a message for each invalid setting
+func validateConfig() []string {
+	problems := config.Service.Validate("TRIP_PORT")
+	if config.PaymentProvider != "local" {
+		problems = append(problems, "PAYMENT_PROVIDER must be \"local\"")
+	}
+	if config.PassengerGrpcAddress == "" {
+		problems = append(problems, "PASSENGER_GRPC_ADDRESS is required")
+	}
+	if config.DriverGrpcAddress == "" {
+		problems = append(problems, "DRIVER_GRPC_ADDRESS is required")
+	}
+	return problems
+}
+
+/*
+This function loads the config into the global "config" and returns the arguments left after the flags.
+It stops the program if the config is invalid, or after printing it for --print-config
+*/
+func loadConfig() []string {
+	config = defaultConfig()
+	return settings.Load(defaultConfigFile, configSettings(), validateConfig)
+}
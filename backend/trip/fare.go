@@ -0,0 +1,149 @@
+package main
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	"shared/httputil"
+	"shared/service"
+)
+
+//Fares are in cents
+const baseFare = 300
+const farePerSector = 40
+
+//Fare multiplier for each ride class
+var rideClassRates = map[string]float64{
+	"standard":   1.0,
+	"xl":         1.5,
+	"premium":    2.0,
+	"wheelchair": 1.0,
+}
+
+//Vehicle classes that can take each ride class, the same as the driver microservice's
+var eligibleVehicleClasses = map[string][]string{
+	"standard":   {"standard", "xl", "premium"},
+	"xl":         {"xl"},
+	"premium":    {"premium"},
+	"wheelchair": {"wheelchair"},
+}
+
+//Breakdown of what a passenger pays for a trip
+type FareQuote struct {
+	SurgeMultiplier float64
+	FullFare        int
+	Discount        int
+	Fare            int
+	PromoCode       string
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpGetFare(w http.ResponseWriter, r *http.Request) {
+	urlParams := r.URL.Query()
+	passengerId, _ := strconv.Atoi(urlParams.Get("passengerId"))
+	pickUpPostal, _ := strconv.Atoi(urlParams.Get("pickUpPostal"))
+	dropOffPostal, _ := strconv.Atoi(urlParams.Get("dropOffPostal"))
+
+	quote, err := getFare(passengerId, pickUpPostal, dropOffPostal, urlParams.Get("rideClass"), urlParams.Get("promoCode"))
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusOK, quote.toApi())
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+/*
+Returns the fare a passenger would pay for a trip without booking it,
+so that invalid promo codes can be rejected before booking
+*/
+func getFare(passengerId int, pickUpPostal int, dropOffPostal int, rideClass string, promoCode string) (FareQuote, error) {
+	if rideClass == "" {
+		rideClass = "standard"
+	}
+
+	err := service.FirstError(
+		service.RequireField(pickUpPostal, "pickUpPostal"),
+		service.RequireField(dropOffPostal, "dropOffPostal"),
+		validateRideClass(rideClass),
+	)
+	if err != nil {
+		return FareQuote{}, err
+	}
+
+	quote, _, err := quoteFare(passengerId, pickUpPostal, dropOffPostal, rideClass, promoCode)
+	if err != nil {
+		return quote, service.NewError(http.StatusUnprocessableEntity, err.Error())
+	}
+	return quote, nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+/*
+This function prices a trip, applying surge in the pick up region and then the promo code, if any.
+The promo code is returned so that it can be redeemed once the trip is booked
+*/
+func quoteFare(passengerId int, pickUpPostal int, dropOffPostal int, rideClass string, code string) (FareQuote, PromoCode, error) {
+	quote := FareQuote{
+		SurgeMultiplier: surgeMultiplier(pickUpPostal),
+	}
+	fare := float64(calculateFare(pickUpPostal, dropOffPostal)) * rideClassRates[rideClass]
+	quote.FullFare = int(math.Round(fare * quote.SurgeMultiplier))
+	quote.Fare = quote.FullFare
+
+	if code == "" {
+		return quote, PromoCode{}, nil
+	}
+
+	promoCode, err := findUsablePromoCode(code, passengerId, quote.FullFare)
+	if err != nil {
+		return quote, promoCode, err
+	}
+
+	quote.PromoCode = promoCode.Code
+	quote.Discount = promoDiscount(promoCode, quote.FullFare)
+	quote.Fare = quote.FullFare - quote.Discount
+	return quote, promoCode, nil
+}
+
+/*
+This function estimates the fare of a trip from its postal codes.
+The first 2 digits of a postal code is its postal sector,
+so the difference between sectors is used as a rough measure of distance
+*/
+func calculateFare(pickUpPostal int, dropOffPostal int) int {
+	distance := postalSector(pickUpPostal) - postalSector(dropOffPostal)
+	if distance < 0 {
+		distance = -distance
+	}
+	return baseFare + distance*farePerSector
+}
+
+func postalSector(postal int) int {
+	return postal / 10000
+}
+
+//Returns an error unless rideClass is one that is priced
+func validateRideClass(rideClass string) error {
+	if _, ok := rideClassRates[rideClass]; !ok {
+		return service.NewError(http.StatusBadRequest, "RideClass field is missing/incorrect.")
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"shared/proto/pbtime"
+	"shared/proto/trippb"
+	"shared/service"
+)
+
+//The gRPC server calls the same service functions as the HTTP functions, see shared/proto/trip.proto.
+//Errors are turned into gRPC statuses by shared/rpc
+type tripServer struct {
+	trippb.UnimplementedTripServiceServer
+}
+
+func (tripServer) ListTrips(ctx context.Context, req *trippb.ListTripsRequest) (*trippb.Trips, error) {
+	trips, err := listTrips(ctx, int(req.GetPassengerId()), int(req.GetDriverId()), req.GetIncludeDeleted())
+	if err != nil {
+		return nil, err
+	}
+	return trippb.FromTrips(tripsToApi(trips)), nil
+}
+
+func (tripServer) GetTrip(ctx context.Context, req *trippb.Id) (*trippb.Trip, error) {
+	trip, err := getTrip(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return trippb.FromTrip(trip.toApi()), nil
+}
+
+func (tripServer) CreateTrip(ctx context.Context, req *trippb.Trip) (*trippb.Trip, error) {
+	trip, err := createTrip(ctx, tripFromApi(req.ToModel()))
+	if err != nil {
+		return nil, err
+	}
+	return trippb.FromTrip(trip.toApi()), nil
+}
+
+func (tripServer) UpdateTrip(ctx context.Context, req *trippb.Trip) (*trippb.Trip, error) {
+	trip, err := updateTrip(ctx, int(req.GetId()), tripFromApi(req.ToModel()), service.IfMatchVersion(int(req.GetVersion())))
+	if err != nil {
+		return nil, err
+	}
+	return trippb.FromTrip(trip.toApi()), nil
+}
+
+func (tripServer) DeleteTrip(ctx context.Context, req *trippb.VersionedId) (*trippb.Result, error) {
+	message, err := deleteTrip(ctx, int(req.GetId()), service.IfMatchVersion(int(req.GetVersion())))
+	if err != nil {
+		return nil, err
+	}
+	return &trippb.Result{Message: message}, nil
+}
+
+func (tripServer) RestoreTrip(ctx context.Context, req *trippb.Id) (*trippb.Result, error) {
+	message, err := restoreTrip(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &trippb.Result{Message: message}, nil
+}
+
+func (tripServer) GetTripPayment(ctx context.Context, req *trippb.Id) (*trippb.Payment, error) {
+	payment, err := getTripPayment(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &trippb.Payment{
+		Id:          int64(payment.Id),
+		TripId:      int64(payment.TripId),
+		PassengerId: int64(payment.PassengerId),
+		Amount:      int64(payment.Amount),
+		Reference:   payment.Reference,
+		Status:      payment.Status,
+	}, nil
+}
+
+func (tripServer) GetFare(ctx context.Context, req *trippb.FareRequest) (*trippb.FareQuote, error) {
+	quote, err := getFare(int(req.GetPassengerId()), int(req.GetPickUpPostal()), int(req.GetDropOffPostal()), req.GetRideClass(), req.GetPromoCode())
+	if err != nil {
+		return nil, err
+	}
+	return trippb.FromFareQuote(quote.toApi()), nil
+}
+
+func (tripServer) GetSurge(ctx context.Context, req *emptypb.Empty) (*trippb.Surge, error) {
+	surge := &trippb.Surge{Regions: map[int64]*trippb.SurgeRegion{}}
+	for sector, region := range getSurge() {
+		surge.Regions[int64(sector)] = &trippb.SurgeRegion{
+			WaitingTrips:     int64(region.WaitingTrips),
+			AvailableDrivers: region.AvailableDrivers,
+			Multiplier:       region.Multiplier,
+		}
+	}
+	return surge, nil
+}
+
+func (tripServer) ListPromoCodes(ctx context.Context, req *emptypb.Empty) (*trippb.PromoCodes, error) {
+	promoCodes, err := listPromoCodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pbPromoCodes := make([]*trippb.PromoCode, len(promoCodes))
+	for i, promoCode := range promoCodes {
+		pbPromoCodes[i] = promoCodeToPb(promoCode)
+	}
+	return &trippb.PromoCodes{PromoCodes: pbPromoCodes}, nil
+}
+
+func (tripServer) CreatePromoCode(ctx context.Context, req *trippb.PromoCode) (*trippb.PromoCode, error) {
+	promoCode, err := createPromoCode(ctx, PromoCode{
+		Code:                req.GetCode(),
+		DiscountType:        req.GetDiscountType(),
+		DiscountValue:       int(req.GetDiscountValue()),
+		MinFare:             int(req.GetMinFare()),
+		ExpiresAt:           pbtime.RequiredTime(req.GetExpiresAt()),
+		MaxUses:             int(req.GetMaxUses()),
+		MaxUsesPerPassenger: int(req.GetMaxUsesPerPassenger()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return promoCodeToPb(promoCode), nil
+}
+
+func (tripServer) DeletePromoCode(ctx context.Context, req *trippb.PromoCodeRequest) (*trippb.Result, error) {
+	message, err := deletePromoCode(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &trippb.Result{Message: message}, nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func promoCodeToPb(promoCode PromoCode) *trippb.PromoCode {
+	return &trippb.PromoCode{
+		Id:                  int64(promoCode.Id),
+		Code:                promoCode.Code,
+		DiscountType:        promoCode.DiscountType,
+		DiscountValue:       int64(promoCode.DiscountValue),
+		MinFare:             int64(promoCode.MinFare),
+		ExpiresAt:           pbtime.RequiredTimestamp(promoCode.ExpiresAt),
+		MaxUses:             int64(promoCode.MaxUses),
+		MaxUsesPerPassenger: int64(promoCode.MaxUsesPerPassenger),
+		Uses:                int64(promoCode.Uses),
+	}
+}
@@ -1,195 +1,450 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
-	"os"
-	"reflect"
 	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
-	"github.com/joho/godotenv"
-	"gorm.io/driver/mysql"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux"
 	"gorm.io/gorm"
+
+	"shared/database"
+	"shared/httputil"
+	"shared/logging"
+	"shared/metrics"
+	"shared/models"
+	"shared/openapi"
+	"shared/proto/driverpb"
+	"shared/proto/trippb"
+	"shared/rpc"
+	"shared/server"
+	"shared/service"
+	"shared/tracing"
 )
 
 type Trip struct {
-	Id            int
-	PassengerId   int
-	DriverId      int
-	PickUpPostal  int
-	DropOffPostal int
-	Status        string //"waiting", "driving" or "finished"
+	Id              int
+	PassengerId     int
+	DriverId        int
+	VehicleId       int //driver's active vehicle when the trip was booked
+	PickUpPostal    int
+	DropOffPostal   int
+	RideClass       string //"standard", "xl", "premium" or "wheelchair"
+	PartySize       int
+	Fare            int //in cents, after Discount
+	Discount        int //in cents
+	PromoCode       string
+	SurgeMultiplier float64
+	Status          string //"waiting", "driving", "finished" or "cancelled"
+	CreatedAt       time.Time
+	Version         int            `gorm:"not null;default:1"` //bumped on every change, sent as the ETag
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+
+	//Only accepted on creation, the token itself is kept on the trip's payment
+	PaymentMethodToken string `gorm:"-" json:",omitempty"`
+}
+
+//Statuses a trip is allowed to move to from each status
+var tripTransitions = map[string][]string{
+	"waiting": {"driving", "cancelled"},
+	"driving": {"finished"},
 }
 
+const serviceName = "trip"
+
 //Global Variables
 var db *gorm.DB
 
 func main() {
-	loadEnv()
-	initDb()
-	migrateDb()
-	initRouter()
+	args := loadConfig()
+	logging.Init(serviceName)
+	tracing.Init(serviceName, config.Service)
+	db = database.Open(config.Dsn)
+
+	if len(args) > 0 && args[0] == "migrate" {
+		database.RunMigrateCommand(db, serviceName, args[1:])
+		return
+	}
+
+	latestSchemaVersion := database.CheckSchemaVersion(db, serviceName)
+	initPaymentProvider()
+	startSurgeMonitor()
+	startPurgeMonitor()
+	initRouter(latestSchemaVersion)
 }
 
-func loadEnv() {
-	err := godotenv.Load("../.env")
+func initRouter(latestSchemaVersion int) {
+	router := mux.NewRouter()
+
+	router.HandleFunc("/healthz", server.GetHealth).Methods("GET")
+	router.HandleFunc("/readyz", server.GetReadiness(db, serviceName, latestSchemaVersion)).Methods("GET")
+	router.Use(otelmux.Middleware(serviceName))
+	router.Use(logging.Middleware)
+	router.Use(httputil.AdminMiddleware(config.AdminPassword))
+	metrics.Init(router, db, tripStatusCollector{}, tripBookings)
+	openapi.Init(router, "openapi.yaml")
+
+	router.HandleFunc("/trips", httpGetTrips).Methods("GET")
+	router.HandleFunc("/trips/{id}", httpGetTripById).Methods("GET")
+	router.HandleFunc("/trips", httpCreateTrip).Methods("POST")
+	router.HandleFunc("/trips/{id}", httpUpdateTrip).Methods("PUT")
+	router.HandleFunc("/trips/{id}", httpDeleteTrip).Methods("DELETE")
+	router.HandleFunc("/trips/{id}/restore", httpRestoreTrip).Methods("POST")
+	router.HandleFunc("/trips/{id}/payment", httpGetTripPayment).Methods("GET")
+	router.HandleFunc("/fares", httpGetFare).Methods("GET")
+	router.HandleFunc("/surge", httpGetSurge).Methods("GET")
+	router.HandleFunc("/promoCodes", httpGetPromoCodes).Methods("GET")
+	router.HandleFunc("/promoCodes", httpCreatePromoCode).Methods("POST")
+	router.HandleFunc("/promoCodes/{code}", httpDeletePromoCode).Methods("DELETE")
+
+	grpcServer := rpc.NewServer(config.AdminPassword)
+	trippb.RegisterTripServiceServer(grpcServer, tripServer{})
+
+	server.Start("Trip", router, grpcServer, config.Service, db)
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpGetTrips(w http.ResponseWriter, r *http.Request) {
+	urlParams := r.URL.Query()
+	passengerId, _ := strconv.Atoi(urlParams.Get("passengerId"))
+	driverId, _ := strconv.Atoi(urlParams.Get("driverId"))
+	includeDeleted, _ := strconv.ParseBool(urlParams.Get("includeDeleted"))
+
+	trips, err := listTrips(r.Context(), passengerId, driverId, includeDeleted)
 	if err != nil {
-		log.Fatal("Error loading .env file")
+		httputil.RespondWithError(w, err)
+		return
 	}
-}
 
-func initDb() {
-	dsn := os.Getenv("DSN")
+	httputil.RespondWith(w, http.StatusOK, tripsToApi(trips))
+}
 
-	var err error
+func httpGetTripById(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
 
-	//set global var "db"
-	db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	trip, err := getTrip(r.Context(), id)
 	if err != nil {
-		panic("Failed to connect to database with DSN: " + dsn)
+		httputil.RespondWithError(w, err)
+		return
 	}
+
+	httputil.SetETag(w, trip.Version)
+	httputil.RespondWith(w, http.StatusOK, trip.toApi())
 }
 
-func migrateDb() {
-	err := db.AutoMigrate(&Trip{})
+func httpCreateTrip(w http.ResponseWriter, r *http.Request) {
+	var body models.Trip
+
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
+
+	trip, err := createTrip(r.Context(), tripFromApi(body))
 	if err != nil {
-		panic("DB Migration failed with error: " + err.Error())
+		httputil.RespondWithError(w, err)
+		return
 	}
+
+	httputil.SetETag(w, trip.Version)
+	httputil.RespondWith(w, http.StatusCreated, trip.toApi())
 }
 
-func initRouter() {
-	router := mux.NewRouter()
+func httpUpdateTrip(w http.ResponseWriter, r *http.Request) {
+	var body models.Trip
+
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
+
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	trip, err := updateTrip(r.Context(), id, tripFromApi(body), httputil.IfMatch(r))
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
 
-	router.HandleFunc("/trips", getTrips).Methods("GET")
-	router.HandleFunc("/trips/{id}", getTripById).Methods("GET")
-	router.HandleFunc("/trips", createTrip).Methods("POST")
-	router.HandleFunc("/trips/{id}", updateTrip).Methods("PUT")
-	router.HandleFunc("/trips/{id}", deleteTrip).Methods("DELETE")
+	httputil.SetETag(w, trip.Version)
+	httputil.RespondWith(w, http.StatusAccepted, trip.toApi())
+}
 
-	portNo := os.Getenv("TRIP_PORT")
+func httpDeleteTrip(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
 
-	fmt.Printf("Trip Microservice running on port %s...\n", portNo)
-	err := http.ListenAndServe(":"+portNo, router)
+	message, err := deleteTrip(r.Context(), id, httputil.IfMatch(r))
 	if err != nil {
-		panic("InitRouter failed with error: " + err.Error())
+		httputil.RespondWithError(w, err)
+		return
 	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
 }
 
 /////////////////////////
 //                     //
-//    HTTP Functions   //
+//  Service Functions  //
 //                     //
 /////////////////////////
 
-func getTrips(w http.ResponseWriter, r *http.Request) {
+//Lists the trips of the passenger or driver, or all of them if neither is given
+func listTrips(ctx context.Context, passengerId int, driverId int, includeDeleted bool) ([]Trip, error) {
 	var trips []Trip
 
-	urlParams := r.URL.Query()
-	if queryPassengerId, ok := urlParams["passengerId"]; ok {
-		stringPassengerId := queryPassengerId[0]
-		passengerId, _ := strconv.Atoi(stringPassengerId)
-		db.Where("passenger_id = ?", passengerId).Find(&trips)
-	} else if queryDriverId, ok := urlParams["driverId"]; ok {
-		stringDriverId := queryDriverId[0]
-		driverId, _ := strconv.Atoi(stringDriverId)
-		db.Where("driver_id = ?", driverId).Find(&trips)
-	} else {
-		db.Find(&trips)
+	query, err := database.ListQuery(ctx, db, includeDeleted)
+	if err != nil {
+		return nil, err
 	}
 
-	httpRespondWith(w, http.StatusOK, trips)
+	if passengerId != 0 {
+		query.Where("passenger_id = ?", passengerId).Find(&trips)
+	} else if driverId != 0 {
+		query.Where("driver_id = ?", driverId).Find(&trips)
+	} else {
+		query.Find(&trips)
+	}
+	return trips, nil
 }
 
-func getTripById(w http.ResponseWriter, r *http.Request) {
-	params := mux.Vars(r)
-	id := params["id"]
+func getTrip(ctx context.Context, id int) (Trip, error) {
+	db := db.WithContext(ctx)
 
 	var trip Trip
 	err := db.Where("id = ?", id).First(&trip).Error
 	if err != nil {
-		httpRespondWith(w, http.StatusNotFound, "Trip doesn't exist")
-		return
+		return trip, service.NewError(http.StatusNotFound, "Trip doesn't exist")
 	}
-
-	httpRespondWith(w, http.StatusOK, trip)
+	return trip, nil
 }
 
-func createTrip(w http.ResponseWriter, r *http.Request) {
-	var trip Trip
+func createTrip(ctx context.Context, trip Trip) (Trip, error) {
+	db := db.WithContext(ctx)
 
-	decodeErr := json.NewDecoder(r.Body).Decode(&trip)
-	if decodeErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
-		return
+	err := service.FirstError(
+		service.RequireField(trip.PassengerId, "PassengerId"),
+		service.RequireField(trip.DriverId, "DriverId"),
+		service.RequireField(trip.PickUpPostal, "PickUpPostal"),
+		service.RequireField(trip.DropOffPostal, "PickUpPostal"),
+		service.RequireField(trip.PaymentMethodToken, "PaymentMethodToken"),
+	)
+	if err != nil {
+		return trip, err
 	}
 
-	if isFieldMissing(w, trip.PassengerId, "PassengerId") ||
-		isFieldMissing(w, trip.DriverId, "DriverId") ||
-		isFieldMissing(w, trip.PickUpPostal, "PickUpPostal") ||
-		isFieldMissing(w, trip.DropOffPostal, "PickUpPostal") {
-		return
+	//a single standard ride unless asked otherwise
+	if trip.RideClass == "" {
+		trip.RideClass = "standard"
+	}
+	if trip.PartySize == 0 {
+		trip.PartySize = 1
+	}
+	err = validateRideClass(trip.RideClass)
+	if err != nil {
+		return trip, err
+	}
+	if trip.PartySize < 0 {
+		return trip, service.NewError(http.StatusBadRequest, "PartySize field is missing/incorrect.")
 	}
 
-	//Disallow manual setting of Id
+	//Disallow manual setting of Id and Version
 	trip.Id = 0
+	trip.Version = 1
+
+	//the trip is always in the vehicle the driver is driving
+	trip.VehicleId, err = checkTripDriver(ctx, trip)
+	if err != nil {
+		tripBookings.WithLabelValues(trip.RideClass, "driver_unavailable").Inc()
+		return trip, err
+	}
 
 	//initialise trips as "waiting"
 	trip.Status = "waiting"
 
+	quote, promoCode, quoteErr := quoteFare(trip.PassengerId, trip.PickUpPostal, trip.DropOffPostal, trip.RideClass, trip.PromoCode)
+	if quoteErr != nil {
+		tripBookings.WithLabelValues(trip.RideClass, "fare_rejected").Inc()
+		return trip, service.NewError(http.StatusUnprocessableEntity, quoteErr.Error())
+	}
+	trip.Fare = quote.Fare
+	trip.SurgeMultiplier = quote.SurgeMultiplier
+	trip.Discount = quote.Discount
+	trip.PromoCode = quote.PromoCode
+
+	err = checkPaymentMethodOwner(ctx, trip.PassengerId, trip.PaymentMethodToken)
+	if err != nil {
+		tripBookings.WithLabelValues(trip.RideClass, "payment_failed").Inc()
+		return trip, err
+	}
+
+	//hold the fare before booking so that unpaid trips are never created
+	payment, paymentErr := authorisePayment(trip)
+	if paymentErr != nil {
+		tripBookings.WithLabelValues(trip.RideClass, "payment_failed").Inc()
+		return trip, service.NewError(http.StatusPaymentRequired, "Payment authorisation failed: "+paymentErr.Error())
+	}
+
 	dbErr := db.Create(&trip).Error
 	if dbErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid Data")
-		return
+		paymentProvider.Refund(payment.Reference, payment.Amount)
+		tripBookings.WithLabelValues(trip.RideClass, "failed").Inc()
+		return trip, service.NewError(http.StatusBadRequest, "Invalid Data")
 	}
 
-	httpRespondWith(w, http.StatusCreated, trip)
-}
+	//a payment that isn't recorded could never be captured, so the booking is undone
+	payment.TripId = trip.Id
+	dbErr = db.Create(&payment).Error
+	if dbErr != nil {
+		paymentProvider.Refund(payment.Reference, payment.Amount)
+		db.Unscoped().Delete(&trip)
+		tripBookings.WithLabelValues(trip.RideClass, "failed").Inc()
+		return trip, service.NewError(http.StatusInternalServerError, "Payment could not be recorded")
+	}
 
-func updateTrip(w http.ResponseWriter, r *http.Request) {
-	var trip Trip
+	if trip.PromoCode != "" {
+		redeemErr := redeemPromoCode(promoCode, trip)
+		if redeemErr != nil {
+			//the code ran out while booking, so undo the trip
+			refundTripPayment(trip.Id)
+			db.Unscoped().Delete(&trip)
+			tripBookings.WithLabelValues(trip.RideClass, "promo_unavailable").Inc()
+			return trip, service.NewError(http.StatusUnprocessableEntity, redeemErr.Error())
+		}
+	}
 
-	decodeErr := json.NewDecoder(r.Body).Decode(&trip)
-	if decodeErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
-		return
+	//the driver is only put on the trip if they are still available, else someone else got them first
+	err = claimTripDriver(ctx, trip)
+	if err != nil {
+		refundTripPayment(trip.Id)
+		releasePromoCode(trip.Id)
+		db.Unscoped().Delete(&trip)
+		tripBookings.WithLabelValues(trip.RideClass, "driver_unavailable").Inc()
+		return trip, err
 	}
 
-	//Disallow manual setting of Id
+	//token is not part of the trip once booked
+	trip.PaymentMethodToken = ""
+	tripBookings.WithLabelValues(trip.RideClass, "booked").Inc()
+	return trip, nil
+}
+
+func updateTrip(ctx context.Context, id int, trip Trip, ifMatch service.IfMatch) (Trip, error) {
+	db := db.WithContext(ctx)
+
+	//Disallow manual setting of Id, pricing and what was booked
 	trip.Id = 0
+	trip.DriverId = 0
+	trip.VehicleId = 0
+	trip.RideClass = ""
+	trip.PartySize = 0
+	trip.Fare = 0
+	trip.Discount = 0
+	trip.PromoCode = ""
+	trip.SurgeMultiplier = 0
+
+	oldTrip, err := getTrip(ctx, id)
+	if err != nil {
+		return oldTrip, err
+	}
+	err = ifMatch.Check(oldTrip.Version)
+	if err != nil {
+		return oldTrip, err
+	}
+	trip.Version = oldTrip.Version + 1
 
-	params := mux.Vars(r)
-	id := params["id"]
+	statusChanged := trip.Status != "" && trip.Status != oldTrip.Status
+	if statusChanged && !isValidTransition(oldTrip.Status, trip.Status) {
+		errorMsg := fmt.Sprintf("Trip cannot go from %s to %s", oldTrip.Status, trip.Status)
+		return oldTrip, service.NewError(http.StatusConflict, errorMsg)
+	}
 
-	dbErr := db.Model(&Trip{}).Where("id = ?", id).Updates(trip).Error
-	if dbErr != nil {
-		httpRespondWith(w, http.StatusBadRequest, "Invalid Data")
-		return
+	//the change is saved first so that only one of two concurrent requests settles the payment
+	result := db.Model(&Trip{}).Where("id = ? AND version = ?", id, oldTrip.Version).Updates(trip)
+	if result.Error != nil {
+		return oldTrip, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	if result.RowsAffected == 0 {
+		return oldTrip, service.VersionChanged()
+	}
+
+	//settle the trip's payment when it finishes or is cancelled, and undo the change if it can't be
+	if statusChanged {
+		var paymentErr error
+		if trip.Status == "finished" {
+			paymentErr = captureTripPayment(oldTrip.Id)
+		} else if trip.Status == "cancelled" {
+			paymentErr = refundTripPayment(oldTrip.Id)
+		}
+		if paymentErr != nil {
+			revertedTrip := oldTrip
+			revertedTrip.Version = trip.Version + 1
+			revertResult := db.Model(&Trip{}).Where("id = ? AND version = ?", id, trip.Version).
+				Select("*").Omit("Id", "CreatedAt", "DeletedAt").Updates(&revertedTrip)
+			if revertResult.Error != nil || revertResult.RowsAffected == 0 {
+				logging.Error("Trip could not be reverted after its payment failed", map[string]interface{}{"tripId": oldTrip.Id, "status": oldTrip.Status})
+				errorMsg := fmt.Sprintf("Payment could not be settled and the trip state is inconsistent, it is %s but its payment is not settled", trip.Status)
+				return oldTrip, service.NewError(http.StatusInternalServerError, errorMsg)
+			}
+			return oldTrip, service.NewError(http.StatusPaymentRequired, "Payment could not be settled: "+paymentErr.Error())
+		}
+
+		if trip.Status == "cancelled" {
+			releasePromoCode(oldTrip.Id)
+		}
+	}
+
+	//the driver is free once the trip is over, which sending the same status again retries
+	if trip.Status == "finished" || trip.Status == "cancelled" {
+		err = releaseTripDriver(ctx, oldTrip)
+		if err != nil {
+			return oldTrip, err
+		}
 	}
 
 	var newTrip Trip
 	db.Where("id = ?", id).First(&newTrip)
-
-	httpRespondWith(w, http.StatusAccepted, newTrip)
+	return newTrip, nil
 }
 
-func deleteTrip(w http.ResponseWriter, r *http.Request) {
-	params := mux.Vars(r)
-	id := params["id"]
+func deleteTrip(ctx context.Context, id int, ifMatch service.IfMatch) (string, error) {
+	db := db.WithContext(ctx)
 
-	//check trip exist
-	idExist := existInDb("id", id)
-	if !idExist {
-		httpRespondWith(w, http.StatusNotFound, "Trip doesn't exist")
-		return
+	trip, err := getTrip(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	err = ifMatch.Check(trip.Version)
+	if err != nil {
+		return "", err
+	}
+
+	//the payment, promo code and driver are only settled when a trip is cancelled or finished.
+	//A status change bumps the version, so the delete fails if the trip is started in the meantime
+	if trip.Status == "waiting" || trip.Status == "driving" {
+		return "", service.NewError(http.StatusConflict, "Active trips must be cancelled or finished before they are deleted")
 	}
 
-	//delete from passenger where id = id
-	db.Delete(&Trip{}, id)
+	//soft delete so that the trip can be restored until purged
+	result := db.Where("version = ?", trip.Version).Delete(&trip)
+	if result.RowsAffected == 0 {
+		return "", service.VersionChanged()
+	}
 
-	httpRespondWith(w, http.StatusAccepted, fmt.Sprintf("Trip of ID %s successfully deleted", id))
+	return fmt.Sprintf("Trip of ID %d successfully deleted", id), nil
 }
 
 /////////////////////////
@@ -198,29 +453,94 @@ func deleteTrip(w http.ResponseWriter, r *http.Request) {
 //                     //
 /////////////////////////
 
-func httpRespondWith(w http.ResponseWriter, statusCode int, data interface{}) {
-	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(data)
-}
-
-func isFieldMissing(w http.ResponseWriter, data interface{}, fieldName string) bool {
-	if isZero(data) {
-		errorMsg := fmt.Sprintf("%s field is missing/incorrect.", fieldName)
-		httpRespondWith(w, http.StatusBadRequest, errorMsg)
-		return true
+func isValidTransition(fromStatus string, toStatus string) bool {
+	for _, status := range tripTransitions[fromStatus] {
+		if status == toStatus {
+			return true
+		}
 	}
 	return false
 }
 
-func isZero(data interface{}) bool {
-	value := reflect.ValueOf(data)
-	return value.IsZero()
+/*
+This function asks the driver microservice whether the driver can take the trip and returns the
+vehicle they are driving, so that trips can't be booked in a vehicle of the passenger's choosing.
+It fails with 409 Conflict if the driver isn't available or their vehicle doesn't fit the ride class
+and party size, and 502 Bad Gateway if the driver microservice can't be asked
+*/
+func checkTripDriver(ctx context.Context, trip Trip) (int, error) {
+	driver, err := driverClient.GetDriver(ctx, &driverpb.Id{Id: int64(trip.DriverId)})
+	if err != nil {
+		if rpc.HttpStatus(err) == http.StatusNotFound {
+			return 0, service.NewError(http.StatusUnprocessableEntity, "Driver doesn't exist")
+		}
+		return 0, service.NewError(http.StatusBadGateway, "Could not check driver: "+rpc.Message(err))
+	}
+
+	if driver.GetActiveVehicleId() == 0 {
+		return 0, service.NewError(http.StatusConflict, "Driver has no active vehicle")
+	}
+	if !driver.GetAvailable() {
+		return 0, service.NewError(http.StatusConflict, "Driver is not available")
+	}
+
+	vehicle, err := vehicleClient.GetVehicle(ctx, &driverpb.Id{Id: driver.GetActiveVehicleId()})
+	if err != nil {
+		return 0, service.NewError(http.StatusBadGateway, "Could not check driver's vehicle: "+rpc.Message(err))
+	}
+
+	fitsClass := false
+	for _, vehicleClass := range eligibleVehicleClasses[trip.RideClass] {
+		if vehicle.GetVehicleClass() == vehicleClass {
+			fitsClass = true
+		}
+	}
+	if !fitsClass {
+		errorMsg := fmt.Sprintf("Driver's %s vehicle can't take %s rides", vehicle.GetVehicleClass(), trip.RideClass)
+		return 0, service.NewError(http.StatusConflict, errorMsg)
+	}
+	if int(vehicle.GetSeats()) < trip.PartySize {
+		errorMsg := fmt.Sprintf("Driver's vehicle only has %d seats", vehicle.GetSeats())
+		return 0, service.NewError(http.StatusConflict, errorMsg)
+	}
+
+	return int(driver.GetActiveVehicleId()), nil
 }
 
-func existInDb(fieldName string, value interface{}) bool {
-	var dbTrip Trip
+/*
+This function puts the driver on the trip, which the driver microservice only does while they are
+available so that a driver can't be booked for two trips. It fails with 409 Conflict if they
+aren't available anymore and 502 Bad Gateway if the driver microservice can't be asked
+*/
+func claimTripDriver(ctx context.Context, trip Trip) error {
+	_, err := driverClient.SetActiveTrip(ctx, &driverpb.ActiveTripRequest{
+		DriverId: int64(trip.DriverId),
+		TripId:   int64(trip.Id),
+	})
+	if err != nil {
+		if rpc.HttpStatus(err) == http.StatusConflict {
+			return service.NewError(http.StatusConflict, "Driver is no longer available")
+		}
+		return service.NewError(http.StatusBadGateway, "Could not assign driver: "+rpc.Message(err))
+	}
+	return nil
+}
 
-	//if email doens't exist, db.First returns a ErrRecordNotFound
-	err := db.Where(fieldName+" = ?", value).First(&dbTrip).Error
-	return err != gorm.ErrRecordNotFound
+/*
+This function takes the driver off the trip once it is over. A driver who isn't on the trip
+anymore, or no longer exists, has already been released
+*/
+func releaseTripDriver(ctx context.Context, trip Trip) error {
+	_, err := driverClient.SetActiveTrip(ctx, &driverpb.ActiveTripRequest{
+		DriverId:       int64(trip.DriverId),
+		ReleasedTripId: int64(trip.Id),
+	})
+	if err != nil {
+		status := rpc.HttpStatus(err)
+		if status == http.StatusConflict || status == http.StatusNotFound {
+			return nil
+		}
+		return service.NewError(http.StatusBadGateway, "Trip is over but the driver could not be released, send it again: "+rpc.Message(err))
+	}
+	return nil
 }
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//Counted when scraped, so that they are right whichever instance made the change
+var tripsDesc = prometheus.NewDesc("hytchhyke_trips", "Trips that aren't deleted by status", []string{"status"}, nil)
+
+type tripStatusCollector struct{}
+
+func (tripStatusCollector) Describe(descs chan<- *prometheus.Desc) {
+	descs <- tripsDesc
+}
+
+func (tripStatusCollector) Collect(metrics chan<- prometheus.Metric) {
+	var counts []struct {
+		Status string
+		Count  int64
+	}
+	db.Model(&Trip{}).Select("status, COUNT(*) AS count").Group("status").Scan(&counts)
+
+	for _, count := range counts {
+		metrics <- prometheus.MustNewConstMetric(tripsDesc, prometheus.GaugeValue, float64(count.Count), count.Status)
+	}
+}
+
+//Results are "booked", "driver_unavailable", "fare_rejected", "payment_failed", "failed" or "promo_unavailable"
+var tripBookings = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "hytchhyke_trip_bookings_total",
+	Help: "Attempts to book a trip by ride class and result",
+}, []string{"ride_class", "result"})
@@ -0,0 +1,10 @@
+-- The table as the first release's AutoMigrate made it, IF NOT EXISTS lets its databases be adopted
+CREATE TABLE IF NOT EXISTS trips (
+    id bigint NOT NULL AUTO_INCREMENT,
+    passenger_id bigint,
+    driver_id bigint,
+    pick_up_postal bigint,
+    drop_off_postal bigint,
+    status longtext,
+    PRIMARY KEY (id)
+);
@@ -0,0 +1,2 @@
+DROP TABLE payments;
+ALTER TABLE trips DROP COLUMN fare;
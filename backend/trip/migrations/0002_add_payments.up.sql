@@ -0,0 +1,11 @@
+ALTER TABLE trips ADD COLUMN fare bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE payments (
+    id bigint NOT NULL AUTO_INCREMENT,
+    trip_id bigint,
+    passenger_id bigint,
+    amount bigint,
+    reference longtext,
+    status longtext,
+    PRIMARY KEY (id)
+);
@@ -0,0 +1,4 @@
+DROP TABLE promo_redemptions;
+DROP TABLE promo_codes;
+ALTER TABLE trips DROP COLUMN promo_code;
+ALTER TABLE trips DROP COLUMN discount;
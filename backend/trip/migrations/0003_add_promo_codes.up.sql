@@ -0,0 +1,24 @@
+ALTER TABLE trips ADD COLUMN discount bigint NOT NULL DEFAULT 0;
+ALTER TABLE trips ADD COLUMN promo_code longtext;
+
+CREATE TABLE promo_codes (
+    id bigint NOT NULL AUTO_INCREMENT,
+    code varchar(32),
+    discount_type longtext,
+    discount_value bigint,
+    min_fare bigint,
+    expires_at datetime(3) NULL,
+    max_uses bigint,
+    max_uses_per_passenger bigint,
+    uses bigint,
+    PRIMARY KEY (id),
+    UNIQUE INDEX idx_promo_codes_code (code)
+);
+
+CREATE TABLE promo_redemptions (
+    id bigint NOT NULL AUTO_INCREMENT,
+    promo_code_id bigint,
+    passenger_id bigint,
+    trip_id bigint,
+    PRIMARY KEY (id)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE trips DROP COLUMN created_at;
+ALTER TABLE trips DROP COLUMN surge_multiplier;
@@ -0,0 +1,3 @@
+-- Trips from before surge pricing were charged the normal fare
+ALTER TABLE trips ADD COLUMN surge_multiplier double NOT NULL DEFAULT 1;
+ALTER TABLE trips ADD COLUMN created_at datetime(3) NULL;
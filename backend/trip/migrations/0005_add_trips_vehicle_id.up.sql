@@ -0,0 +1 @@
+ALTER TABLE trips ADD COLUMN vehicle_id bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE trips DROP COLUMN party_size;
+ALTER TABLE trips DROP COLUMN ride_class;
@@ -0,0 +1,2 @@
+ALTER TABLE trips ADD COLUMN ride_class longtext;
+ALTER TABLE trips ADD COLUMN party_size bigint NOT NULL DEFAULT 0;
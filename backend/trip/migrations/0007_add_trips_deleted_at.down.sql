@@ -0,0 +1,2 @@
+DROP INDEX idx_trips_deleted_at ON trips;
+ALTER TABLE trips DROP COLUMN deleted_at;
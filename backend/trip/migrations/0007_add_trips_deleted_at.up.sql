@@ -0,0 +1,2 @@
+ALTER TABLE trips ADD COLUMN deleted_at datetime(3) NULL;
+CREATE INDEX idx_trips_deleted_at ON trips (deleted_at);
@@ -0,0 +1 @@
+ALTER TABLE trips ADD COLUMN version bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+DROP INDEX idx_promo_redemptions_passenger_seq ON promo_redemptions;
+ALTER TABLE promo_redemptions DROP COLUMN seq;
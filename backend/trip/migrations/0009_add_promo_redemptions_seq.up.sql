@@ -0,0 +1,14 @@
+-- Each of a passenger's uses of a code is numbered, so that a unique index stops concurrent
+-- bookings from going over MaxUsesPerPassenger. Existing uses of codes with a limit are numbered in the
+-- order they were made, the others keep a NULL seq
+ALTER TABLE promo_redemptions ADD COLUMN seq bigint NULL;
+
+UPDATE promo_redemptions
+JOIN (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY promo_code_id, passenger_id ORDER BY id) AS use_number
+    FROM promo_redemptions
+) numbered ON numbered.id = promo_redemptions.id
+JOIN promo_codes ON promo_codes.id = promo_redemptions.promo_code_id AND promo_codes.max_uses_per_passenger > 0
+SET promo_redemptions.seq = numbered.use_number;
+
+CREATE UNIQUE INDEX idx_promo_redemptions_passenger_seq ON promo_redemptions (promo_code_id, passenger_id, seq);
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"shared/httputil"
+	"shared/proto/passengerpb"
+	"shared/rpc"
+	"shared/service"
+)
+
+/*
+PaymentProvider is implemented by anything that can hold, charge and return money.
+Authorise places a hold of amount (in cents) on the payment method behind token and
+returns a reference, which is then used to Capture or Refund the held amount
+*/
+type PaymentProvider interface {
+	Authorise(token string, amount int) (string, error)
+	Capture(reference string, amount int) error
+	Refund(reference string, amount int) error
+}
+
+type Payment struct {
+	Id          int `gorm:"primaryKey"`
+	TripId      int
+	PassengerId int
+	Amount      int //in cents
+	Reference   string
+	Status      string //"authorised", "captured" or "refunded"
+}
+
+var paymentProvider PaymentProvider
+
+//Payment methods are kept by the passenger microservice, which is asked who a token belongs to
+var passengerClient passengerpb.PassengerServiceClient
+
+func initPaymentProvider() {
+	switch config.PaymentProvider {
+	case "local":
+		paymentProvider = localPaymentProvider{}
+	default:
+		log.Fatal("Unknown PAYMENT_PROVIDER: " + config.PaymentProvider)
+	}
+
+	conn, err := rpc.Dial(config.PassengerGrpcAddress)
+	if err != nil {
+		log.Fatal("Connecting to the passenger microservice failed: " + err.Error())
+	}
+	passengerClient = passengerpb.NewPassengerServiceClient(conn)
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpGetTripPayment(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	payment, err := getTripPayment(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusOK, payment)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+func getTripPayment(ctx context.Context, tripId int) (Payment, error) {
+	db := db.WithContext(ctx)
+
+	var payment Payment
+	err := db.Where("trip_id = ?", tripId).First(&payment).Error
+	if err != nil {
+		return payment, service.NewError(http.StatusNotFound, "Payment doesn't exist")
+	}
+	return payment, nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+/*
+This function checks that token is one of the passenger's payment methods, so that a passenger
+can't book with someone else's. It fails with 402 Payment Required if it isn't
+and 502 Bad Gateway if the passenger microservice can't be asked
+*/
+func checkPaymentMethodOwner(ctx context.Context, passengerId int, token string) error {
+	paymentMethods, err := passengerClient.ListPaymentMethods(ctx, &passengerpb.Id{Id: int64(passengerId)})
+	if err != nil {
+		return service.NewError(http.StatusBadGateway, "Could not check payment method: "+rpc.Message(err))
+	}
+
+	for _, paymentMethod := range paymentMethods.GetPaymentMethods() {
+		if paymentMethod.GetToken() == token {
+			return nil
+		}
+	}
+	return service.NewError(http.StatusPaymentRequired, "Payment method doesn't belong to the passenger")
+}
+
+/*
+This function places a hold for the trip's fare on the passenger's payment method.
+The returned payment is not saved as the trip does not have an Id yet
+*/
+func authorisePayment(trip Trip) (Payment, error) {
+	reference, err := paymentProvider.Authorise(trip.PaymentMethodToken, trip.Fare)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	payment := Payment{
+		PassengerId: trip.PassengerId,
+		Amount:      trip.Fare,
+		Reference:   reference,
+		Status:      "authorised",
+	}
+	return payment, nil
+}
+
+func captureTripPayment(tripId int) error {
+	var payment Payment
+	err := db.Where("trip_id = ?", tripId).First(&payment).Error
+	if err != nil {
+		return errors.New("trip has no payment")
+	}
+	if payment.Status != "authorised" {
+		return errors.New("payment is already " + payment.Status)
+	}
+
+	err = paymentProvider.Capture(payment.Reference, payment.Amount)
+	if err != nil {
+		return err
+	}
+
+	return db.Model(&payment).Update("status", "captured").Error
+}
+
+func refundTripPayment(tripId int) error {
+	var payment Payment
+	err := db.Where("trip_id = ?", tripId).First(&payment).Error
+	if err != nil {
+		return errors.New("trip has no payment")
+	}
+	if payment.Status == "refunded" {
+		return nil
+	}
+
+	err = paymentProvider.Refund(payment.Reference, payment.Amount)
+	if err != nil {
+		return err
+	}
+
+	return db.Model(&payment).Update("status", "refunded").Error
+}
+
+/////////////////////////
+//                     //
+//   Local Provider    //
+//                     //
+/////////////////////////
+
+/*
+localPaymentProvider is a fake provider for development and testing.
+It accepts any token issued by the passenger service ("tok_local_...") and never moves real money.
+It keeps no state, the payment's status in the database is the source of truth
+*/
+type localPaymentProvider struct{}
+
+const localTokenPrefix = "tok_local_"
+const localReferencePrefix = "auth_local_"
+
+func (localPaymentProvider) Authorise(token string, amount int) (string, error) {
+	if !strings.HasPrefix(token, localTokenPrefix) {
+		return "", errors.New("payment method declined")
+	}
+	if amount < 0 {
+		return "", errors.New("invalid amount")
+	}
+
+	randomBytes := make([]byte, 8)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+	return localReferencePrefix + hex.EncodeToString(randomBytes), nil
+}
+
+func (localPaymentProvider) Capture(reference string, amount int) error {
+	if !strings.HasPrefix(reference, localReferencePrefix) {
+		return errors.New("unknown payment reference")
+	}
+	return nil
+}
+
+func (localPaymentProvider) Refund(reference string, amount int) error {
+	if !strings.HasPrefix(reference, localReferencePrefix) {
+		return errors.New("unknown payment reference")
+	}
+	return nil
+}
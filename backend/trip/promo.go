@@ -0,0 +1,285 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"gorm.io/gorm"
+
+	"shared/database"
+	"shared/httputil"
+	"shared/service"
+)
+
+type PromoCode struct {
+	Id                  int    `gorm:"primaryKey"`
+	Code                string `gorm:"uniqueIndex;size:32"`
+	DiscountType        string //"percentage" or "flat"
+	DiscountValue       int    //percent off, or cents off
+	MinFare             int    //in cents, 0 for no minimum
+	ExpiresAt           time.Time
+	MaxUses             int //across all passengers, 0 for unlimited
+	MaxUsesPerPassenger int //0 for unlimited
+	Uses                int
+}
+
+/*
+Records which passenger used a promo code on which trip. For codes with a MaxUsesPerPassenger,
+each use is one of the passenger's numbered Seq, which a unique index lets only one trip have
+*/
+type PromoRedemption struct {
+	Id          int `gorm:"primaryKey"`
+	PromoCodeId int
+	PassengerId int
+	TripId      int
+	Seq         *int //1 to MaxUsesPerPassenger, nil if the code has no per passenger limit
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpGetPromoCodes(w http.ResponseWriter, r *http.Request) {
+	promoCodes, err := listPromoCodes(r.Context())
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusOK, promoCodes)
+}
+
+func httpCreatePromoCode(w http.ResponseWriter, r *http.Request) {
+	var body PromoCode
+
+	decodeErr := json.NewDecoder(r.Body).Decode(&body)
+	if decodeErr != nil {
+		httputil.RespondWith(w, http.StatusBadRequest, "Invalid JSON: "+decodeErr.Error())
+		return
+	}
+
+	promoCode, err := createPromoCode(r.Context(), body)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusCreated, promoCode)
+}
+
+func httpDeletePromoCode(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+
+	message, err := deletePromoCode(r.Context(), params["code"])
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+func listPromoCodes(ctx context.Context) ([]PromoCode, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var promoCodes []PromoCode
+	db.Find(&promoCodes)
+	return promoCodes, nil
+}
+
+func createPromoCode(ctx context.Context, promoCode PromoCode) (PromoCode, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return promoCode, err
+	}
+
+	//validate empty fields
+	err = service.FirstError(
+		service.RequireField(promoCode.Code, "Code"),
+		service.RequireField(promoCode.DiscountValue, "DiscountValue"),
+		service.RequireField(promoCode.ExpiresAt, "ExpiresAt"),
+	)
+	if err != nil {
+		return promoCode, err
+	}
+
+	if promoCode.DiscountType != "percentage" && promoCode.DiscountType != "flat" {
+		return promoCode, service.NewError(http.StatusBadRequest, "DiscountType must be percentage or flat.")
+	}
+	if promoCode.DiscountType == "percentage" && promoCode.DiscountValue > 100 {
+		return promoCode, service.NewError(http.StatusBadRequest, "Percentage discounts cannot exceed 100.")
+	}
+
+	//codes are case insensitive
+	promoCode.Code = strings.ToUpper(promoCode.Code)
+
+	var existing PromoCode
+	if db.Where("code = ?", promoCode.Code).First(&existing).Error == nil {
+		return promoCode, service.NewError(http.StatusConflict, "Promo code already exists.")
+	}
+
+	//Disallow manual setting of Id and Uses
+	promoCode.Id = 0
+	promoCode.Uses = 0
+
+	dbErr := db.Create(&promoCode).Error
+	if dbErr != nil {
+		return promoCode, service.NewError(http.StatusBadRequest, "Invalid Data")
+	}
+	return promoCode, nil
+}
+
+func deletePromoCode(ctx context.Context, code string) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	code = strings.ToUpper(code)
+
+	result := db.Where("code = ?", code).Delete(&PromoCode{})
+	if result.RowsAffected == 0 {
+		return "", service.NewError(http.StatusNotFound, "Promo code doesn't exist")
+	}
+
+	return fmt.Sprintf("Promo code %s successfully deleted", code), nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+/*
+This function looks up a promo code and checks that the passenger can use it on the given fare.
+The returned error explains why the code cannot be used
+*/
+func findUsablePromoCode(code string, passengerId int, fare int) (PromoCode, error) {
+	var promoCode PromoCode
+	err := db.Where("code = ?", strings.ToUpper(code)).First(&promoCode).Error
+	if err != nil {
+		return promoCode, fmt.Errorf("Promo code %s doesn't exist", code)
+	}
+
+	if time.Now().After(promoCode.ExpiresAt) {
+		return promoCode, fmt.Errorf("Promo code %s has expired", promoCode.Code)
+	}
+	if promoCode.MaxUses != 0 && promoCode.Uses >= promoCode.MaxUses {
+		return promoCode, fmt.Errorf("Promo code %s has been fully redeemed", promoCode.Code)
+	}
+	if fare < promoCode.MinFare {
+		return promoCode, fmt.Errorf("Promo code %s requires a minimum fare of $%.2f", promoCode.Code, float64(promoCode.MinFare)/100)
+	}
+
+	if promoCode.MaxUsesPerPassenger != 0 {
+		var passengerUses int64
+		db.Model(&PromoRedemption{}).
+			Where("promo_code_id = ? AND passenger_id = ?", promoCode.Id, passengerId).
+			Count(&passengerUses)
+		if int(passengerUses) >= promoCode.MaxUsesPerPassenger {
+			return promoCode, fmt.Errorf("You have already used promo code %s the maximum number of times", promoCode.Code)
+		}
+	}
+
+	return promoCode, nil
+}
+
+func promoDiscount(promoCode PromoCode, fare int) int {
+	discount := promoCode.DiscountValue
+	if promoCode.DiscountType == "percentage" {
+		discount = fare * promoCode.DiscountValue / 100
+	}
+	if discount > fare {
+		discount = fare
+	}
+	return discount
+}
+
+/*
+This function records the use of a promo code on a trip. The passenger's use is claimed first,
+then Uses is incremented with a guarded update, so concurrent bookings cannot exceed
+MaxUsesPerPassenger or MaxUses
+*/
+func redeemPromoCode(promoCode PromoCode, trip Trip) error {
+	redemption, err := claimPassengerUse(promoCode, trip)
+	if err != nil {
+		return err
+	}
+
+	result := db.Model(&PromoCode{}).
+		Where("id = ? AND (max_uses = 0 OR uses < max_uses)", promoCode.Id).
+		Update("uses", gorm.Expr("uses + 1"))
+	if result.Error != nil {
+		db.Delete(&redemption)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		db.Delete(&redemption)
+		return errors.New("Promo code " + promoCode.Code + " has been fully redeemed")
+	}
+	return nil
+}
+
+/*
+This function records the passenger's use of the promo code in the first of their
+MaxUsesPerPassenger numbered uses that no other trip has, failing if they have all been taken
+*/
+func claimPassengerUse(promoCode PromoCode, trip Trip) (PromoRedemption, error) {
+	redemption := PromoRedemption{
+		PromoCodeId: promoCode.Id,
+		PassengerId: trip.PassengerId,
+		TripId:      trip.Id,
+	}
+	if promoCode.MaxUsesPerPassenger == 0 {
+		return redemption, db.Create(&redemption).Error
+	}
+
+	for seq := 1; seq <= promoCode.MaxUsesPerPassenger; seq++ {
+		use := seq
+		redemption.Id = 0
+		redemption.Seq = &use
+
+		err := db.Create(&redemption).Error
+		if err == nil {
+			return redemption, nil
+		}
+		if !database.IsDuplicateKeyError(err) {
+			return redemption, err
+		}
+	}
+	return redemption, fmt.Errorf("You have already used promo code %s the maximum number of times", promoCode.Code)
+}
+
+//Gives back the promo code's use when its trip is cancelled
+func releasePromoCode(tripId int) {
+	var redemption PromoRedemption
+	err := db.Where("trip_id = ?", tripId).First(&redemption).Error
+	if err != nil {
+		return
+	}
+
+	db.Delete(&redemption)
+	db.Model(&PromoCode{}).Where("id = ? AND uses > 0", redemption.PromoCodeId).
+		Update("uses", gorm.Expr("uses - 1"))
+}
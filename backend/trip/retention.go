@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"shared/httputil"
+	"shared/server"
+	"shared/service"
+)
+
+const purgeInterval = time.Hour
+
+/*
+This function hard deletes trips that were deleted more than
+DELETED_RETENTION_DAYS ago, along with their payments
+*/
+func startPurgeMonitor() {
+	retention := time.Duration(config.DeletedRetentionDays) * 24 * time.Hour
+
+	purgeDeletedTrips(retention)
+
+	server.RunEvery(purgeInterval, func() {
+		purgeDeletedTrips(retention)
+	})
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpRestoreTrip(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id, _ := strconv.Atoi(params["id"])
+
+	message, err := restoreTrip(r.Context(), id)
+	if err != nil {
+		httputil.RespondWithError(w, err)
+		return
+	}
+
+	httputil.RespondWith(w, http.StatusAccepted, message)
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+func restoreTrip(ctx context.Context, id int) (string, error) {
+	db := db.WithContext(ctx)
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var trip Trip
+	err = db.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&trip).Error
+	if err != nil {
+		return "", service.NewError(http.StatusNotFound, "Deleted trip doesn't exist")
+	}
+
+	db.Unscoped().Model(&trip).Update("deleted_at", nil)
+
+	return fmt.Sprintf("Trip of ID %d successfully restored", id), nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+//Promo redemptions are kept so that purging a trip doesn't give the passenger another use
+func purgeDeletedTrips(retention time.Duration) {
+	var trips []Trip
+	db.Unscoped().Where("deleted_at < ?", time.Now().Add(-retention)).Find(&trips)
+
+	for _, trip := range trips {
+		db.Where("trip_id = ?", trip.Id).Delete(&Payment{})
+		db.Unscoped().Delete(&trip)
+	}
+}
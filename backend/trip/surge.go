@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"shared/httputil"
+	"shared/logging"
+	"shared/proto/driverpb"
+	"shared/rpc"
+	"shared/server"
+)
+
+//Only trips booked within the window count as demand
+const surgeWindow = 15 * time.Minute
+const surgeRefreshInterval = 30 * time.Second
+const maxSurgeMultiplier = 2.5
+
+//How much the multiplier rises for each waiting trip per available driver above 1
+const surgeSensitivity = 0.5
+
+//Supply and demand of one postal region, keyed by postal sector
+type SurgeRegion struct {
+	WaitingTrips     int
+	AvailableDrivers float64
+	Multiplier       float64
+}
+
+var surgeMutex sync.RWMutex
+var surgeRegions = map[int]SurgeRegion{}
+
+//The driver microservice is asked for available drivers and, when booking, whether the driver can take the trip
+var driverClient driverpb.DriverServiceClient
+var vehicleClient driverpb.VehicleServiceClient
+
+/*
+This function recomputes the surge map in the background every surgeRefreshInterval.
+Fares are quoted against the last computed map so a slow driver service never slows down booking
+*/
+func startSurgeMonitor() {
+	conn, err := rpc.Dial(config.DriverGrpcAddress)
+	if err != nil {
+		log.Fatal("Connecting to the driver microservice failed: " + err.Error())
+	}
+	driverClient = driverpb.NewDriverServiceClient(conn)
+	vehicleClient = driverpb.NewVehicleServiceClient(conn)
+
+	refreshSurge()
+
+	server.RunEvery(surgeRefreshInterval, func() {
+		refreshSurge()
+	})
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func httpGetSurge(w http.ResponseWriter, r *http.Request) {
+	httputil.RespondWith(w, http.StatusOK, getSurge())
+}
+
+/////////////////////////
+//                     //
+//  Service Functions  //
+//                     //
+/////////////////////////
+
+//Returns a copy of the surge map, so that callers never share it with a refresh
+func getSurge() map[int]SurgeRegion {
+	surgeMutex.RLock()
+	defer surgeMutex.RUnlock()
+
+	regions := make(map[int]SurgeRegion, len(surgeRegions))
+	for sector, region := range surgeRegions {
+		regions[sector] = region
+	}
+	return regions
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+//Returns the multiplier for the region of the given postal code, 1 if there is no surge
+func surgeMultiplier(postal int) float64 {
+	surgeMutex.RLock()
+	defer surgeMutex.RUnlock()
+
+	region, ok := surgeRegions[postalSector(postal)]
+	if !ok {
+		return 1
+	}
+	return region.Multiplier
+}
+
+func refreshSurge() {
+	driverIds, err := getAvailableDriverIds()
+	if err != nil {
+		//keep the last map rather than dropping surge while the driver service is down
+		logging.Error("Surge refresh failed", map[string]interface{}{"error": err.Error()})
+		return
+	}
+
+	regions := map[int]SurgeRegion{}
+
+	//demand is where waiting trips were booked
+	var waitingTrips []Trip
+	db.Where("status = ? AND created_at > ?", "waiting", time.Now().Add(-surgeWindow)).Find(&waitingTrips)
+	for _, trip := range waitingTrips {
+		sector := postalSector(trip.PickUpPostal)
+		region := regions[sector]
+		region.WaitingTrips++
+		regions[sector] = region
+	}
+
+	//supply is where available drivers last dropped someone off
+	var lastTrips []Trip
+	lastTripIds := db.Model(&Trip{}).Select("MAX(id)").
+		Where("status = ? AND driver_id IN ?", "finished", driverIds).Group("driver_id")
+	err = db.Where("id IN (?)", lastTripIds).Find(&lastTrips).Error
+	if err != nil {
+		logging.Error("Surge refresh failed", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	unlocatedDrivers := len(driverIds) - len(lastTrips)
+	for _, lastTrip := range lastTrips {
+		sector := postalSector(lastTrip.DropOffPostal)
+		region := regions[sector]
+		region.AvailableDrivers++
+		regions[sector] = region
+	}
+
+	//drivers that have never driven could be anywhere, so share them between regions with demand
+	if len(regions) > 0 {
+		share := float64(unlocatedDrivers) / float64(len(regions))
+		for sector, region := range regions {
+			region.AvailableDrivers += share
+			regions[sector] = region
+		}
+	}
+
+	for sector, region := range regions {
+		region.Multiplier = calculateSurgeMultiplier(region.WaitingTrips, region.AvailableDrivers)
+		regions[sector] = region
+	}
+
+	surgeMutex.Lock()
+	surgeRegions = regions
+	surgeMutex.Unlock()
+}
+
+func calculateSurgeMultiplier(waitingTrips int, availableDrivers float64) float64 {
+	//a region with no drivers is treated as having 1 so that the ratio stays finite
+	ratio := float64(waitingTrips) / math.Max(availableDrivers, 1)
+	if ratio <= 1 {
+		return 1
+	}
+
+	multiplier := 1 + (ratio-1)*surgeSensitivity
+	multiplier = math.Min(multiplier, maxSurgeMultiplier)
+
+	//round to 1 decimal place so that passengers see multipliers like x1.5
+	return math.Round(multiplier*10) / 10
+}
+
+func getAvailableDriverIds() ([]int, error) {
+	ctx := logging.WithRequestId(context.Background(), logging.NewRequestId())
+
+	available := true
+	drivers, err := driverClient.ListDrivers(ctx, &driverpb.ListDriversRequest{Available: &available})
+	if err != nil {
+		return nil, err
+	}
+
+	driverIds := make([]int, len(drivers.GetDrivers()))
+	for i, driver := range drivers.GetDrivers() {
+		driverIds[i] = int(driver.GetId())
+	}
+	return driverIds, nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"strings"
+
+	"shared/settings"
+)
+
+//See shared/settings for how the config is loaded
+type Config struct {
+	GatewayUrl string
+
+	TraceExporter string
+	OtlpEndpoint  string
+	OtlpInsecure  bool
+}
+
+var config Config
+
+const defaultConfigFile = ".env"
+
+func defaultConfig() Config {
+	return Config{
+		GatewayUrl: "http://localhost:8000",
+
+		TraceExporter: "none",
+		OtlpEndpoint:  "localhost:4318",
+		OtlpInsecure:  true,
+	}
+}
+
+func configSettings() []*settings.Setting {
+	return []*settings.Setting{
+		{Name: "GATEWAY_URL", Value: &config.GatewayUrl, Usage: "URL of the gateway in front of the microservices"},
+		{Name: "TRACE_EXPORTER", Value: &config.TraceExporter, Usage: "where spans are sent, \"none\" or \"otlp\""},
+		{Name: "OTLP_ENDPOINT", Value: &config.OtlpEndpoint, Usage: "host:port of the OTLP/HTTP collector"},
+		{Name: "OTLP_INSECURE", Value: &config.OtlpInsecure, Usage: "send spans to the collector without TLS"},
+	}
+}
+
+//Returns a message for each invalid setting
+func validateConfig() []string {
+	problems := []string{}
+	for _, s := range configSettings() {
+		if strings.HasSuffix(s.Name, "_URL") && !settings.IsHttpUrl(s.String()) {
+			problems = append(problems, s.Name+" must be a http or https URL")
+		}
+	}
+	//spans aren't printed to stdout as they would get in the way of the menus
+	if config.TraceExporter != "none" && config.TraceExporter != "otlp" {
+		problems = append(problems, "TRACE_EXPORTER must be \"none\" or \"otlp\"")
+	}
+	if config.TraceExporter == "otlp" && config.OtlpEndpoint == "" {
+		problems = append(problems, "OTLP_ENDPOINT is required for the otlp exporter")
+	}
+	return problems
+}
+
+/*
+This function loads the config into the global "config" and returns the arguments left after the flags.
+It stops the program if the config is invalid, or after printing it for --print-config
+*/
+func loadConfig() []string {
+	config = defaultConfig()
+	return settings.Load(defaultConfigFile, configSettings(), validateConfig)
+}
@@ -2,51 +2,90 @@ package main
 
 import (
 	"bufio"
-	"bytes"
-	"encoding/json"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"mime"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
+
+	"shared/client"
+	"shared/models"
 )
 
-type Passenger struct {
-	Id        int
-	FirstName string
-	LastName  string
-	MobileNo  int
-	Email     string
-}
+//The microservices' models, so that the console doesn't compile if they change
+type Passenger = models.Passenger
+type PaymentMethod = models.PaymentMethod
+type Driver = models.Driver
+type Suspension = models.Suspension
+type Vehicle = models.Vehicle
+type Trip = models.Trip
+type TripDetails = models.TripDetails
+type FareQuote = models.FareQuote
 
-type Driver struct {
-	Id           int
-	FirstName    string
-	LastName     string
-	MobileNo     int
-	Email        string
-	CarLicenseNo string
-	Available    bool
-}
+var documentTypes = []string{"licence", "vehicle_registration", "insurance"}
 
-type Trip struct {
-	Id            int
-	PassengerId   int
-	DriverId      int
-	PickUpPostal  int
-	DropOffPostal int
-	Status        string
-}
+var scanner *bufio.Scanner
 
-var passengerUrl string = "http://localhost:5000/passengers"
-var driverUrl string = "http://localhost:5001/drivers"
-var tripUrl string = "http://localhost:5002/trips"
+//Sent as X-Request-ID with every request, a new one for each menu option chosen
+//so that everything a user action did can be found in the logs of all 3 microservices
+var requestId string
 
-var scanner *bufio.Scanner
+//Calls the microservices, set up once the config is loaded
+var api *client.Client
 
 func main() {
+	loadConfig()
+	initTracing()
+	initClient()
 	scanner = bufio.NewScanner(os.Stdin)
 
+	checkBackends()
 	mainMenu()
+	shutdownTracing()
+}
+
+func initClient() {
+	gatewayUrl := strings.TrimSuffix(config.GatewayUrl, "/")
+	api = &client.Client{
+		PassengerUrl: gatewayUrl + "/passengers",
+		DriverUrl:    gatewayUrl + "/drivers",
+		VehicleUrl:   gatewayUrl + "/vehicles",
+		TripUrl:      gatewayUrl + "/trips",
+		FareUrl:      gatewayUrl + "/fares",
+		HttpClient:   httpClient,
+	}
+}
+
+//Warns about any microservice that isn't ready, the console still starts so that the others can be used
+func checkBackends() {
+	readiness, err := getReadiness(config.GatewayUrl)
+	if err == nil {
+		return
+	}
+	if len(readiness.Backends) == 0 {
+		fmt.Println("Warning: Gateway is down: " + err.Error())
+		return
+	}
+
+	backends := []struct {
+		name string
+		key  string
+	}{
+		{"Passenger", "passenger"},
+		{"Driver", "driver"},
+		{"Trip", "trip"},
+	}
+	for _, backend := range backends {
+		if reason := readiness.Backends[backend.key]; reason != "ok" {
+			fmt.Printf("Warning: %s microservice is down: %s\n", backend.name, reason)
+		}
+	}
 }
 
 func mainMenu() {
@@ -59,7 +98,7 @@ menu:
 		fmt.Println("[4] Register Driver Account")
 		fmt.Println("[0] Quit")
 
-		userOption := getStrInput()
+		userOption := getMenuOption()
 		switch userOption {
 		case "1":
 			loginPassenger()
@@ -135,9 +174,11 @@ menu:
 		fmt.Println("[1] Book Trip")
 		fmt.Println("[2] View Trips")
 		fmt.Println("[3] Update Details")
+		fmt.Println("[4] Cancel Trip")
+		fmt.Println("[5] Payment Methods")
 		fmt.Println("[0] Logout")
 
-		userOption := getStrInput()
+		userOption := getMenuOption()
 		switch userOption {
 		case "1":
 			bookTrip(passenger)
@@ -146,6 +187,10 @@ menu:
 		case "3":
 			updatePassengerDetails(passenger)
 			break menu
+		case "4":
+			cancelTrip(passenger)
+		case "5":
+			paymentMethodMenu(passenger)
 		case "0":
 			break menu
 		}
@@ -158,27 +203,63 @@ func bookTrip(passenger Passenger) {
 	fmt.Print("\nDrop Off Postal Code: ")
 	dropOffPostal := getIntInput()
 
+	rideClass := chooseRideClass()
+
+	fmt.Print("Number of Passengers: ")
+	partySize := getIntInput()
+	if partySize < 1 {
+		partySize = 1
+	}
+
+	quote := getFareQuote(passenger, pickUpPostal, dropOffPostal, rideClass)
+	if (quote == FareQuote{}) {
+		return
+	}
+	if quote.SurgeMultiplier > 1 {
+		fmt.Printf("High demand in your area, fares are x%.1f\n", quote.SurgeMultiplier)
+	}
+	if quote.Discount > 0 {
+		fmt.Printf("Fare: $%.2f - $%.2f (%s) = $%.2f\n",
+			float64(quote.FullFare)/100, float64(quote.Discount)/100, quote.PromoCode, float64(quote.Fare)/100)
+	} else {
+		fmt.Printf("Fare: $%.2f\n", float64(quote.Fare)/100)
+	}
+
+	paymentMethod := choosePaymentMethod(passenger)
+	if (paymentMethod == PaymentMethod{}) {
+		fmt.Println("\nPlease add a payment method before booking")
+		return
+	}
+
 	//get available driver
-	driver := getAvailableDriver()
+	driver := getAvailableDriver(rideClass, partySize)
 	if (driver == Driver{}) {
 		fmt.Println("\nNo available drivers")
 		return
 	}
 
-	err := createTrip(pickUpPostal, dropOffPostal, driver.Id, passenger.Id)
+	newTrip := Trip{
+		PickUpPostal:       pickUpPostal,
+		DropOffPostal:      dropOffPostal,
+		DriverId:           driver.Id,
+		VehicleId:          driver.ActiveVehicleId,
+		PassengerId:        passenger.Id,
+		RideClass:          rideClass,
+		PartySize:          partySize,
+		PromoCode:          quote.PromoCode,
+		PaymentMethodToken: paymentMethod.Token,
+	}
+	//the trip microservice puts the driver on the trip, which fails if another passenger got them first
+	trip, err := createTrip(newTrip)
 	if err != nil {
 		fmt.Println("Trip could not be booked: ", err.Error())
-	} else {
-		fmt.Println("Trip booked successfully!")
+		return
 	}
-
-	//set driver to unavailable
-	driver.Available = false
-	updateDriver(driver)
+	fmt.Printf("Trip booked successfully! Fare: $%.2f\n", float64(trip.Fare)/100)
 }
 
 func displayPassengerTrip(passenger Passenger) {
-	trips := getPassengerTrips(passenger.Id)
+	trips := getPassengerTripDetails(passenger.Id)
 
 	//display in reverse chronological order
 	for i := len(trips) - 1; i >= 0; i-- {
@@ -186,13 +267,172 @@ func displayPassengerTrip(passenger Passenger) {
 		fmt.Println("\nTrip ID: ", trip.Id)
 		fmt.Println("Pick Up Postal Code: ", trip.PickUpPostal)
 		fmt.Println("Drop Off Postal Code: ", trip.DropOffPostal)
-		fmt.Println("Driver ID: ", trip.DriverId)
-		fmt.Println("Passenger ID: ", trip.PassengerId)
+		fmt.Printf("Ride: %s for %d\n", trip.RideClass, trip.PartySize)
+		if trip.DriverName != "" {
+			fmt.Printf("Driver: %s (%d)\n", trip.DriverName, trip.DriverMobileNo)
+		}
+		if trip.VehiclePlate != "" {
+			fmt.Printf("Vehicle: %s (%s)\n", trip.VehicleDescription, trip.VehiclePlate)
+		}
+		if trip.PassengerName != "" {
+			fmt.Println("Passenger: ", trip.PassengerName)
+		}
+		fmt.Printf("Fare: $%.2f\n", float64(trip.Fare)/100)
 		fmt.Println("Trip Status", trip.Status)
+		//the rest of the trip is still shown if the driver or passenger microservice is down
+		for _, warning := range trip.Warnings {
+			fmt.Println("Warning: ", warning)
+		}
 		fmt.Println()
 	}
 }
 
+func cancelTrip(passenger Passenger) {
+	trips := getPassengerTrips(passenger.Id)
+	var waitingTrip Trip
+	for _, trip := range trips {
+		if trip.Status == "waiting" {
+			waitingTrip = trip
+		}
+	}
+	if (waitingTrip == Trip{}) {
+		fmt.Println("\nNo waiting trips")
+		return
+	}
+
+	waitingTrip.Status = "cancelled"
+	err := updateTrip(waitingTrip)
+	if err != nil {
+		fmt.Println("Trip could not be cancelled: ", err.Error())
+		return
+	}
+	fmt.Println("\nTrip cancelled, your payment has been refunded")
+}
+
+func paymentMethodMenu(passenger Passenger) {
+menu:
+	for {
+		paymentMethods := getPaymentMethods(passenger.Id)
+		fmt.Println()
+		for i, paymentMethod := range paymentMethods {
+			fmt.Printf("Card %d: **** **** **** %s (expires %02d/%d)\n",
+				i+1, paymentMethod.Last4, paymentMethod.ExpiryMonth, paymentMethod.ExpiryYear)
+		}
+
+		fmt.Println("[1] Add Card")
+		fmt.Println("[2] Remove Card")
+		fmt.Println("[0] Back")
+
+		userOption := getMenuOption()
+		switch userOption {
+		case "1":
+			addPaymentMethod(passenger)
+		case "2":
+			fmt.Print("Card to remove: ")
+			cardNo := getIntInput()
+			if cardNo < 1 || cardNo > len(paymentMethods) {
+				fmt.Println("\nInvalid card")
+				continue
+			}
+			err := deletePaymentMethod(paymentMethods[cardNo-1])
+			if err != nil {
+				fmt.Println("Error: ", err.Error())
+			}
+		case "0":
+			break menu
+		}
+	}
+}
+
+func addPaymentMethod(passenger Passenger) {
+	fmt.Print("Card Number: ")
+	cardNumber := getStrInput()
+
+	fmt.Print("Expiry Month: ")
+	expiryMonth := getIntInput()
+
+	fmt.Print("Expiry Year: ")
+	expiryYear := getIntInput()
+
+	newPaymentMethod := PaymentMethod{
+		PassengerId: passenger.Id,
+		CardNumber:  cardNumber,
+		ExpiryMonth: expiryMonth,
+		ExpiryYear:  expiryYear,
+	}
+
+	err := createPaymentMethod(newPaymentMethod)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+	} else {
+		fmt.Println("Card Added Successfully!")
+	}
+}
+
+/*
+This function asks the passenger for a promo code and prices the trip with it.
+Rejected promo codes are explained and the passenger can try another or leave it blank.
+It returns an empty FareQuote if the trip cannot be priced
+*/
+func getFareQuote(passenger Passenger, pickUpPostal int, dropOffPostal int, rideClass string) FareQuote {
+	for {
+		fmt.Print("Promo Code (leave blank for none): ")
+		promoCode := getStrInput()
+
+		quote, err := getFare(passenger.Id, pickUpPostal, dropOffPostal, rideClass, promoCode)
+		if err == nil {
+			return quote
+		}
+		if promoCode == "" {
+			fmt.Println("Trip could not be priced: ", err.Error())
+			return FareQuote{}
+		}
+		fmt.Println(err.Error())
+	}
+}
+
+func chooseRideClass() string {
+	rideClasses := []string{"standard", "xl", "premium", "wheelchair"}
+	fmt.Println("[1] Standard")
+	fmt.Println("[2] XL")
+	fmt.Println("[3] Premium")
+	fmt.Println("[4] Wheelchair Accessible")
+	for {
+		fmt.Print("Ride Class: ")
+		classNo := getIntInput()
+		if classNo >= 1 && classNo <= len(rideClasses) {
+			return rideClasses[classNo-1]
+		}
+		fmt.Println("Invalid ride class")
+	}
+}
+
+/*
+This function asks the passenger which stored card to pay with.
+It returns an empty PaymentMethod if the passenger has none
+*/
+func choosePaymentMethod(passenger Passenger) PaymentMethod {
+	paymentMethods := getPaymentMethods(passenger.Id)
+	if len(paymentMethods) == 0 {
+		return PaymentMethod{}
+	}
+	if len(paymentMethods) == 1 {
+		return paymentMethods[0]
+	}
+
+	for i, paymentMethod := range paymentMethods {
+		fmt.Printf("[%d] **** **** **** %s\n", i+1, paymentMethod.Last4)
+	}
+	for {
+		fmt.Print("Pay with card: ")
+		cardNo := getIntInput()
+		if cardNo >= 1 && cardNo <= len(paymentMethods) {
+			return paymentMethods[cardNo-1]
+		}
+		fmt.Println("Invalid card")
+	}
+}
+
 func updatePassengerDetails(passenger Passenger) {
 	fmt.Print("New First Name: ")
 	firstName := getStrInput()
@@ -212,6 +452,7 @@ func updatePassengerDetails(passenger Passenger) {
 		LastName:  lastName,
 		MobileNo:  mobileNo,
 		Email:     email,
+		Version:   passenger.Version,
 	}
 	err := updatePassenger(newPassenger)
 	if err != nil {
@@ -241,23 +482,25 @@ func registerDriver() {
 	fmt.Print("Email: ")
 	email := getStrInput()
 
-	fmt.Print("Car Licence Plate Number: ")
-	carLicenseNo := getStrInput()
-
 	newDriver := Driver{
-		FirstName:    firstName,
-		LastName:     lastName,
-		MobileNo:     mobileNo,
-		Email:        email,
-		CarLicenseNo: carLicenseNo,
+		FirstName: firstName,
+		LastName:  lastName,
+		MobileNo:  mobileNo,
+		Email:     email,
 	}
 
-	err := createDriver(newDriver)
+	driver, err := createDriver(newDriver)
 	if err != nil {
 		fmt.Println("Error: ", err.Error())
-	} else {
-		fmt.Println("Driver Registered Successfully!")
+		return
 	}
+	fmt.Println("Driver Registered Successfully!")
+
+	fmt.Println("\nPlease register your vehicle")
+	registerVehicle(driver)
+
+	fmt.Println("\nPlease upload your documents for review")
+	uploadDocuments(driver)
 }
 
 func loginDriver() {
@@ -270,7 +513,12 @@ login:
 			fmt.Println("\nInvalid email")
 			break login
 		} else {
+			if driver.OnboardingStatus == "suspended" {
+				printSuspension(driver)
+				break login
+			}
 			fmt.Printf("\nWelcome %s %s!\n", driver.FirstName, driver.LastName)
+			printOnboardingStatus(driver)
 			driverMenu(driver)
 			//after logout
 			break login
@@ -281,12 +529,32 @@ login:
 func driverMenu(driver Driver) {
 menu:
 	for {
+		//every action counts as activity so that the driver isn't taken offline
+		driverHeartbeat(driver.Id)
+		newDriver := getDriverById(driver.Id)
+		if (newDriver != Driver{}) {
+			driver = newDriver
+		}
+
+		if driver.Online {
+			fmt.Println("\nYou are online")
+		} else {
+			fmt.Println("\nYou are offline")
+		}
+
 		fmt.Println("[1] Start Trip")
 		fmt.Println("[2] End Trip")
 		fmt.Println("[3] Update Details")
+		fmt.Println("[4] Vehicles")
+		if driver.Online {
+			fmt.Println("[5] Go Offline")
+		} else {
+			fmt.Println("[5] Go Online")
+		}
+		fmt.Println("[6] Upload Documents")
 		fmt.Println("[0] Logout")
 
-		userOption := getStrInput()
+		userOption := getMenuOption()
 		switch userOption {
 		case "1":
 			startTrip(driver)
@@ -295,6 +563,12 @@ menu:
 		case "3":
 			updateDriverDetails(driver)
 			break menu
+		case "4":
+			vehicleMenu(driver)
+		case "5":
+			toggleOnline(driver)
+		case "6":
+			uploadDocuments(driver)
 		case "0":
 			break menu
 		}
@@ -314,16 +588,13 @@ func updateDriverDetails(driver Driver) {
 	fmt.Print("New Email: ")
 	email := getStrInput()
 
-	fmt.Print("New Car Licence Plate Number: ")
-	carLicenseNo := getStrInput()
-
 	newDriver := Driver{
-		Id:           driver.Id,
-		FirstName:    firstName,
-		LastName:     lastName,
-		MobileNo:     mobileNo,
-		Email:        email,
-		CarLicenseNo: carLicenseNo,
+		Id:        driver.Id,
+		FirstName: firstName,
+		LastName:  lastName,
+		MobileNo:  mobileNo,
+		Email:     email,
+		Version:   driver.Version,
 	}
 
 	err := updateDriver(newDriver)
@@ -335,6 +606,105 @@ func updateDriverDetails(driver Driver) {
 	}
 }
 
+func vehicleMenu(driver Driver) {
+menu:
+	for {
+		//refresh to show the current active vehicle
+		newDriver := getDriverById(driver.Id)
+		if (newDriver != Driver{}) {
+			driver = newDriver
+		}
+		vehicles := getDriverVehicles(driver.Id)
+		fmt.Println()
+		for i, vehicle := range vehicles {
+			active := ""
+			if vehicle.Id == driver.ActiveVehicleId {
+				active = " (active)"
+			}
+			fmt.Printf("Vehicle %d: %s %s %s, %s, %d seats, %s%s\n", i+1, vehicle.Colour, vehicle.Make,
+				vehicle.Model, vehicle.LicensePlate, vehicle.Seats, vehicle.VehicleClass, active)
+		}
+
+		fmt.Println("[1] Register Vehicle")
+		fmt.Println("[2] Add Existing Vehicle")
+		fmt.Println("[3] Set Active Vehicle")
+		fmt.Println("[0] Back")
+
+		userOption := getMenuOption()
+		switch userOption {
+		case "1":
+			registerVehicle(driver)
+		case "2":
+			fmt.Print("Licence Plate Number: ")
+			plate := getStrInput()
+			vehicle := getVehicleByPlate(plate)
+			if (vehicle == Vehicle{}) {
+				fmt.Println("\nVehicle not found")
+				continue
+			}
+			err := addDriverVehicle(driver.Id, vehicle.Id)
+			if err != nil {
+				fmt.Println("Error: ", err.Error())
+			}
+		case "3":
+			fmt.Print("Vehicle to drive: ")
+			vehicleNo := getIntInput()
+			if vehicleNo < 1 || vehicleNo > len(vehicles) {
+				fmt.Println("\nInvalid vehicle")
+				continue
+			}
+			err := setActiveVehicle(driver.Id, vehicles[vehicleNo-1].Id)
+			if err != nil {
+				fmt.Println("Error: ", err.Error())
+			}
+		case "0":
+			break menu
+		}
+	}
+}
+
+/*
+This function registers a new vehicle, adds it to the driver
+and makes it the driver's active vehicle
+*/
+func registerVehicle(driver Driver) {
+	fmt.Print("Car Licence Plate Number: ")
+	licensePlate := getStrInput()
+
+	fmt.Print("Make: ")
+	vehicleMake := getStrInput()
+
+	fmt.Print("Model: ")
+	model := getStrInput()
+
+	fmt.Print("Colour: ")
+	colour := getStrInput()
+
+	fmt.Print("Passenger Seats: ")
+	seats := getIntInput()
+
+	newVehicle := Vehicle{
+		LicensePlate: licensePlate,
+		Make:         vehicleMake,
+		Model:        model,
+		Colour:       colour,
+		Seats:        seats,
+	}
+
+	vehicle, err := createVehicle(newVehicle)
+	if err == nil {
+		err = addDriverVehicle(driver.Id, vehicle.Id)
+	}
+	if err == nil {
+		err = setActiveVehicle(driver.Id, vehicle.Id)
+	}
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+	} else {
+		fmt.Println("Vehicle Registered Successfully!")
+	}
+}
+
 func startTrip(driver Driver) {
 	//get all trips
 	//update trip where driver_id = driver.id && status == "waiting"
@@ -351,15 +721,18 @@ func startTrip(driver Driver) {
 		fmt.Println("No waiting trips")
 	} else {
 		waitingTrip.Status = "driving"
-		updateTrip(waitingTrip)
+		err := updateTrip(waitingTrip)
+		if err != nil {
+			fmt.Println("Trip could not be started: ", err.Error())
+			return
+		}
 		fmt.Println("\nTrip started")
 	}
 }
 
 func endTrip(driver Driver) {
 	//update db where driver_id = driver.id && status == "driving"
-	//into status = "finish"
-	//update driver available to true
+	//into status = "finish", which releases the driver from the trip
 	driverTrips := getDriverTrips(driver.Id)
 	var drivingTrip Trip
 	for _, trip := range driverTrips {
@@ -371,13 +744,75 @@ func endTrip(driver Driver) {
 		fmt.Println("No driving trips")
 	} else {
 		drivingTrip.Status = "finished"
-		updateTrip(drivingTrip)
+		err := updateTrip(drivingTrip)
+		if err != nil {
+			fmt.Println("Trip could not be ended: ", err.Error())
+			return
+		}
 		fmt.Println("\nTrip ended")
 	}
+}
+
+func printSuspension(driver Driver) {
+	fmt.Println("\nYour account has been suspended")
+
+	suspension, err := getDriverSuspension(driver.Id)
+	if err != nil {
+		return
+	}
+	fmt.Println("Reason: ", strings.ReplaceAll(suspension.ReasonCode, "_", " "))
+	if suspension.Note != "" {
+		fmt.Println("Details: ", suspension.Note)
+	}
+	if suspension.EffectiveUntil != nil {
+		fmt.Println("Suspended until: ", suspension.EffectiveUntil.Local().Format("2 Jan 2006 3:04pm"))
+	}
+	fmt.Println("Please contact HytchHyke support if you have any questions")
+}
 
-	//set driver to available
-	driver.Available = true
-	updateDriver(driver)
+func printOnboardingStatus(driver Driver) {
+	switch driver.OnboardingStatus {
+	case "pending":
+		fmt.Println("Your account is pending, please upload all your documents")
+		if driver.OnboardingNote != "" {
+			fmt.Println("Your documents were not accepted: ", driver.OnboardingNote)
+		}
+	case "under_review":
+		fmt.Println("Your documents are being reviewed, you can start driving once approved")
+	}
+}
+
+/*
+This function asks the driver for the file of each document.
+Documents left blank are skipped so that they can be uploaded later
+*/
+func uploadDocuments(driver Driver) {
+	for _, documentType := range documentTypes {
+		fmt.Printf("Path to %s file (leave blank to skip): ", strings.ReplaceAll(documentType, "_", " "))
+		path := getStrInput()
+		if path == "" {
+			continue
+		}
+
+		err := uploadDocument(driver.Id, documentType, path)
+		if err != nil {
+			fmt.Println("Error: ", err.Error())
+		} else {
+			fmt.Println("Uploaded!")
+		}
+	}
+}
+
+func toggleOnline(driver Driver) {
+	var err error
+	if driver.Online {
+		err = goOffline(driver.Id)
+	} else {
+		err = goOnline(driver.Id)
+	}
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+	}
 }
 
 /////////////////////////
@@ -387,158 +822,208 @@ func endTrip(driver Driver) {
 /////////////////////////
 
 func getPassengerByEmail(email string) Passenger {
-	var passenger Passenger
-
-	url := fmt.Sprintf("%s?email=%s", passengerUrl, email)
-
-	resp, err := http.Get(url)
+	passengers, err := api.GetPassengersByEmail(actionContext, email)
 	if err != nil {
 		fmt.Println(err.Error())
-		return passenger
+		return Passenger{}
 	}
 
 	//email not found
-	if resp.StatusCode != http.StatusOK {
-		return passenger
+	if len(passengers) <= 0 {
+		return Passenger{}
 	}
-
-	var listPassenger []Passenger
-	json.NewDecoder(resp.Body).Decode(&listPassenger)
-	if len(listPassenger) <= 0 {
-		return passenger
-	}
-	passenger = listPassenger[0]
-	return passenger
+	return passengers[0]
 }
 
-func getAvailableDriver() Driver {
-	var driver Driver
-
-	url := fmt.Sprintf("%s?available=%t", driverUrl, true)
-
-	resp, err := http.Get(url)
+func getAvailableDriver(rideClass string, partySize int) Driver {
+	drivers, err := api.GetAvailableDrivers(actionContext, rideClass, partySize)
 	if err != nil {
 		fmt.Println(err.Error())
-		return driver
+		return Driver{}
 	}
 
-	//email not found
-	if resp.StatusCode != http.StatusOK {
-		return driver
-	}
-
-	var listDriver []Driver
-	json.NewDecoder(resp.Body).Decode(&listDriver)
-	if len(listDriver) <= 0 {
-		return driver
+	if len(drivers) <= 0 {
+		return Driver{}
 	}
-	driver = listDriver[0]
-	return driver
+	return drivers[0]
 }
 
 func updateDriver(newDriver Driver) error {
-	url := fmt.Sprintf("%s/%d", driverUrl, newDriver.Id)
-
-	_, err := httpPut(url, newDriver)
+	_, err := api.UpdateDriver(actionContext, newDriver)
 	return err
 }
 
 func getDriverByEmail(email string) Driver {
-	var driver Driver
-
-	url := fmt.Sprintf("%s?email=%s", driverUrl, email)
-
-	resp, err := http.Get(url)
+	drivers, err := api.GetDriversByEmail(actionContext, email)
 	if err != nil {
 		fmt.Println(err.Error())
-		return driver
+		return Driver{}
 	}
 
 	//email not found
-	if resp.StatusCode != http.StatusOK {
-		return driver
+	if len(drivers) <= 0 {
+		return Driver{}
 	}
+	return drivers[0]
+}
 
-	var listDriver []Driver
-	json.NewDecoder(resp.Body).Decode(&listDriver)
-	if len(listDriver) <= 0 {
-		return driver
+func getDriverById(id int) Driver {
+	driver, err := api.GetDriver(actionContext, id)
+	if err != nil {
+		fmt.Println(err.Error())
+		return Driver{}
 	}
-	driver = listDriver[0]
 	return driver
 }
 
-func createTrip(pickUpPostal int, dropOffPostal int, driverId int, passengerId int) error {
-	url := tripUrl
+func createTrip(newTrip Trip) (Trip, error) {
+	return api.CreateTrip(actionContext, newTrip)
+}
 
-	var newTrip Trip = Trip{
+func getFare(passengerId int, pickUpPostal int, dropOffPostal int, rideClass string, promoCode string) (FareQuote, error) {
+	fareRequest := client.FareRequest{
+		PassengerId:   passengerId,
 		PickUpPostal:  pickUpPostal,
 		DropOffPostal: dropOffPostal,
-		DriverId:      driverId,
-		PassengerId:   passengerId,
+		RideClass:     rideClass,
+		PromoCode:     promoCode,
 	}
+	return api.GetFare(actionContext, fareRequest)
+}
 
-	_, err := httpPost(url, newTrip)
-	return err
+func getPaymentMethods(passengerId int) []PaymentMethod {
+	paymentMethods, err := api.GetPaymentMethods(actionContext, passengerId)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+	}
+	return paymentMethods
 }
 
-func getPassengerTrips(id int) []Trip {
-	var trips []Trip
+func createPaymentMethod(newPaymentMethod PaymentMethod) error {
+	_, err := api.CreatePaymentMethod(actionContext, newPaymentMethod)
+	return err
+}
 
-	url := fmt.Sprintf("%s?passengerId=%d", tripUrl, id)
+func deletePaymentMethod(paymentMethod PaymentMethod) error {
+	return api.DeletePaymentMethod(actionContext, paymentMethod.PassengerId, paymentMethod.Id)
+}
 
-	resp, err := http.Get(url)
+func getPassengerTrips(id int) []Trip {
+	trips, err := api.GetPassengerTrips(actionContext, id)
 	if err != nil {
 		fmt.Println("Error: ", err.Error())
-		return trips
 	}
+	return trips
+}
 
-	json.NewDecoder(resp.Body).Decode(&trips)
+func getPassengerTripDetails(id int) []TripDetails {
+	trips, err := api.GetPassengerTripDetails(actionContext, id)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+	}
 	return trips
 }
 
 func updatePassenger(newPassenger Passenger) error {
-	url := fmt.Sprintf("%s/%d", passengerUrl, newPassenger.Id)
-
-	_, err := httpPut(url, newPassenger)
+	_, err := api.UpdatePassenger(actionContext, newPassenger)
 	return err
 }
 
 func createPassenger(newPassenger Passenger) error {
-	url := passengerUrl
-	_, err := httpPost(url, newPassenger)
+	_, err := api.CreatePassenger(actionContext, newPassenger)
 	return err
 }
 
-func createDriver(newDriver Driver) error {
-	url := driverUrl
+func createDriver(newDriver Driver) (Driver, error) {
+	return api.CreateDriver(actionContext, newDriver)
+}
 
-	_, err := httpPost(url, newDriver)
+func goOnline(driverId int) error {
+	_, err := api.GoOnline(actionContext, driverId)
 	return err
 }
 
-func getDriverTrips(id int) []Trip {
-	var trips []Trip
+func goOffline(driverId int) error {
+	_, err := api.GoOffline(actionContext, driverId)
+	return err
+}
 
-	url := fmt.Sprintf("%s?driverId=%d", tripUrl, id)
+func getDriverSuspension(driverId int) (Suspension, error) {
+	return api.GetDriverSuspension(actionContext, driverId)
+}
 
-	resp, err := http.Get(url)
+func uploadDocument(driverId int, documentType string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = api.UploadDriverDocument(actionContext, driverId, documentType, mime.TypeByExtension(filepath.Ext(path)), file)
+	return err
+}
+
+func driverHeartbeat(driverId int) {
+	api.DriverHeartbeat(actionContext, driverId)
+}
+
+func getDriverVehicles(driverId int) []Vehicle {
+	vehicles, err := api.GetDriverVehicles(actionContext, driverId)
 	if err != nil {
 		fmt.Println("Error: ", err.Error())
-		return trips
 	}
+	return vehicles
+}
+
+func getVehicleByPlate(plate string) Vehicle {
+	vehicles, err := api.GetVehiclesByPlate(actionContext, plate)
+	if err != nil {
+		fmt.Println(err.Error())
+		return Vehicle{}
+	}
+
+	if len(vehicles) <= 0 {
+		return Vehicle{}
+	}
+	return vehicles[0]
+}
+
+func createVehicle(newVehicle Vehicle) (Vehicle, error) {
+	return api.CreateVehicle(actionContext, newVehicle)
+}
+
+func addDriverVehicle(driverId int, vehicleId int) error {
+	_, err := api.AddDriverVehicle(actionContext, driverId, vehicleId)
+	return err
+}
+
+func setActiveVehicle(driverId int, vehicleId int) error {
+	_, err := api.SetActiveVehicle(actionContext, driverId, vehicleId)
+	return err
+}
 
-	json.NewDecoder(resp.Body).Decode(&trips)
+func getDriverTrips(id int) []Trip {
+	trips, err := api.GetDriverTrips(actionContext, id)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+	}
 	return trips
 }
 
 func updateTrip(newTrip Trip) error {
-	url := fmt.Sprintf("%s/%d", tripUrl, newTrip.Id)
-
-	_, err := httpPut(url, newTrip)
+	_, err := api.UpdateTrip(actionContext, newTrip)
 	return err
 }
 
+/*
+This function checks the gateway's /readyz, which says why
+each microservice behind it isn't ready if any aren't
+*/
+func getReadiness(gatewayUrl string) (models.Readiness, error) {
+	readinessClient := client.Client{HttpClient: &http.Client{Timeout: 3 * time.Second}}
+	return readinessClient.GetReadiness(context.Background(), gatewayUrl)
+}
+
 /////////////////////////
 //                     //
 //       Helpers       //
@@ -558,21 +1043,17 @@ func getIntInput() int {
 	return intInput
 }
 
-func httpPost(url string, data interface{}) (*http.Response, error) {
-	jsonData, _ := json.Marshal(data)
-
-	response, err := http.Post(url, "application/json", bytes.NewBuffer(jsonData))
-	return response, err
-}
-
-func httpPut(url string, data interface{}) (*http.Response, error) {
-	jsonData, _ := json.Marshal(data)
-
-	request, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonData))
-	request.Header.Set("Content-Type", "application/json")
+/*
+This function reads a menu option and starts a new user action,
+so that the requests it makes share a request ID and trace
+*/
+func getMenuOption() string {
+	option := getStrInput()
 
-	client := &http.Client{}
-	response, err := client.Do(request)
+	randomBytes := make([]byte, 16)
+	rand.Read(randomBytes)
+	requestId = hex.EncodeToString(randomBytes)
+	startUserAction(option)
 
-	return response, err
+	return option
 }
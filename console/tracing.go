@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"shared/client"
+)
+
+var tracer = otel.Tracer("console")
+
+var tracerProvider *sdktrace.TracerProvider
+
+//Sends W3C traceparent headers, so that the microservices' spans join the user action's trace
+var httpClient = &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+
+//The trace of the menu option being handled, requests made for it are part of it
+var actionContext = context.Background()
+var actionSpan trace.Span
+
+/*
+This function sets up tracing, spans are sent to a collector at OTLP_ENDPOINT if TRACE_EXPORTER is "otlp".
+Trace context is passed on to the microservices either way
+*/
+func initTracing() {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String("console"))),
+	}
+	if config.TraceExporter == "otlp" {
+		otlpOptions := []otlptracehttp.Option{otlptracehttp.WithEndpoint(config.OtlpEndpoint)}
+		if config.OtlpInsecure {
+			otlpOptions = append(otlpOptions, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(context.Background(), otlpOptions...)
+		if err != nil {
+			fmt.Println("Tracing is off, failed to create OTLP trace exporter: " + err.Error())
+		} else {
+			options = append(options, sdktrace.WithBatcher(exporter))
+		}
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(tracerProvider)
+}
+
+//Ends the last user action and sends spans that haven't been sent yet
+func shutdownTracing() {
+	if actionSpan != nil {
+		actionSpan.End()
+	}
+	tracerProvider.Shutdown(context.Background())
+}
+
+//Ends the last user action's span and starts one for the menu option chosen
+func startUserAction(option string) {
+	if actionSpan != nil {
+		actionSpan.End()
+	}
+	actionContext, actionSpan = tracer.Start(context.Background(), "user action",
+		trace.WithAttributes(attribute.String("menu.option", option), attribute.String("request.id", requestId)))
+	actionContext = client.WithRequestId(actionContext, requestId)
+}
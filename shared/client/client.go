@@ -0,0 +1,146 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+)
+
+//Identifies everything done for one user action across the microservices
+const RequestIdHeader = "X-Request-ID"
+
+type contextKey string
+
+const requestIdKey contextKey = "requestId"
+
+/*
+Client calls the passenger, driver and trip microservices.
+The URLs are of each resource, e.g. http://localhost:5000/passengers
+*/
+type Client struct {
+	PassengerUrl string
+	DriverUrl    string
+	VehicleUrl   string
+	TripUrl      string
+	FareUrl      string
+
+	//http.DefaultClient if nil
+	HttpClient *http.Client
+}
+
+//Error is returned when a microservice responds with an unexpected status code
+type Error struct {
+	StatusCode int
+	Message    string //the microservice's error message, or the status if it didn't send one
+}
+
+func (err *Error) Error() string {
+	return err.Message
+}
+
+//Returns whether err is from a microservice responding with statusCode
+func IsStatus(err error, statusCode int) bool {
+	clientErr, ok := err.(*Error)
+	return ok && clientErr.StatusCode == statusCode
+}
+
+//Requests made with the returned context are sent with id as their X-Request-ID
+func WithRequestId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIdKey, id)
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+/*
+This function sends a request with data as its JSON body, unless data is nil.
+If the response has expectedStatus, its JSON body is decoded into result unless result is nil
+*/
+func (c *Client) do(ctx context.Context, method string, url string, data interface{}, header http.Header, expectedStatus int, result interface{}) error {
+	var body io.Reader
+	if data != nil {
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewBuffer(jsonData)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		request.Header[key] = values
+	}
+	if data != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.send(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	err = responseError(resp, expectedStatus)
+	if err != nil || result == nil {
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+func (c *Client) send(request *http.Request) (*http.Response, error) {
+	if id, ok := request.Context().Value(requestIdKey).(string); ok {
+		request.Header.Set(RequestIdHeader, id)
+	}
+
+	httpClient := c.HttpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return httpClient.Do(request)
+}
+
+func (c *Client) get(ctx context.Context, url string, result interface{}) error {
+	return c.do(ctx, http.MethodGet, url, nil, nil, http.StatusOK, result)
+}
+
+/*
+This function sends a PUT that fails if the resource is no longer at version,
+so that changes made by someone else in the meantime aren't overwritten
+*/
+func (c *Client) putIfMatch(ctx context.Context, url string, data interface{}, version int, result interface{}) error {
+	header := http.Header{}
+	header.Set("If-Match", fmt.Sprintf("\"%d\"", version))
+	return c.do(ctx, http.MethodPut, url, data, header, http.StatusAccepted, result)
+}
+
+//Returns url with query added as its query string
+func withQuery(url string, query neturl.Values) string {
+	return url + "?" + query.Encode()
+}
+
+/*
+This function returns the microservice's error message as an error
+if the response does not have the expected status code
+*/
+func responseError(resp *http.Response, expectedStatus int) error {
+	if resp.StatusCode == expectedStatus {
+		return nil
+	}
+
+	var errorMsg string
+	json.NewDecoder(resp.Body).Decode(&errorMsg)
+	if errorMsg == "" {
+		errorMsg = resp.Status
+	}
+	return &Error{StatusCode: resp.StatusCode, Message: errorMsg}
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+
+	"shared/models"
+)
+
+/////////////////////////
+//                     //
+//       Driver        //
+//                     //
+/////////////////////////
+
+func (c *Client) GetDriver(ctx context.Context, id int) (models.Driver, error) {
+	var driver models.Driver
+	err := c.get(ctx, fmt.Sprintf("%s/%d", c.DriverUrl, id), &driver)
+	return driver, err
+}
+
+//Returns the drivers with the given email, there is at most one
+func (c *Client) GetDriversByEmail(ctx context.Context, email string) ([]models.Driver, error) {
+	var drivers []models.Driver
+	err := c.get(ctx, withQuery(c.DriverUrl, neturl.Values{"email": {email}}), &drivers)
+	return drivers, err
+}
+
+/*
+Returns the drivers that can take a ride right now.
+Only drivers whose active vehicle is of rideClass and seats partySize are returned,
+unless rideClass is "" and partySize is 0
+*/
+func (c *Client) GetAvailableDrivers(ctx context.Context, rideClass string, partySize int) ([]models.Driver, error) {
+	query := neturl.Values{"available": {"true"}}
+	if rideClass != "" {
+		query.Set("rideClass", rideClass)
+	}
+	if partySize != 0 {
+		query.Set("partySize", strconv.Itoa(partySize))
+	}
+
+	var drivers []models.Driver
+	err := c.get(ctx, withQuery(c.DriverUrl, query), &drivers)
+	return drivers, err
+}
+
+func (c *Client) CreateDriver(ctx context.Context, newDriver models.Driver) (models.Driver, error) {
+	var driver models.Driver
+	err := c.do(ctx, http.MethodPost, c.DriverUrl, newDriver, nil, http.StatusCreated, &driver)
+	return driver, err
+}
+
+//Fails with 412 Precondition Failed if the driver has changed since newDriver.Version
+func (c *Client) UpdateDriver(ctx context.Context, newDriver models.Driver) (models.Driver, error) {
+	var driver models.Driver
+	err := c.putIfMatch(ctx, fmt.Sprintf("%s/%d", c.DriverUrl, newDriver.Id), newDriver, newDriver.Version, &driver)
+	return driver, err
+}
+
+//Sets the trip the driver is on, or 0 when releasedTripId is over
+func (c *Client) SetDriverActiveTrip(ctx context.Context, driverId int, tripId int, releasedTripId int) (models.Driver, error) {
+	var driver models.Driver
+	url := fmt.Sprintf("%s/%d/activeTrip", c.DriverUrl, driverId)
+	body := map[string]int{"TripId": tripId, "ReleasedTripId": releasedTripId}
+	err := c.do(ctx, http.MethodPut, url, body, nil, http.StatusAccepted, &driver)
+	return driver, err
+}
+
+/////////////////////////
+//                     //
+//       Shifts        //
+//                     //
+/////////////////////////
+
+//Starts a shift in the driver's active vehicle
+func (c *Client) GoOnline(ctx context.Context, driverId int) (models.Shift, error) {
+	var shift models.Shift
+	err := c.do(ctx, http.MethodPost, fmt.Sprintf("%s/%d/online", c.DriverUrl, driverId), nil, nil, http.StatusAccepted, &shift)
+	return shift, err
+}
+
+func (c *Client) GoOffline(ctx context.Context, driverId int) (models.Shift, error) {
+	var shift models.Shift
+	err := c.do(ctx, http.MethodPost, fmt.Sprintf("%s/%d/offline", c.DriverUrl, driverId), nil, nil, http.StatusAccepted, &shift)
+	return shift, err
+}
+
+//Lets an online driver show they are still around so they aren't taken offline
+func (c *Client) DriverHeartbeat(ctx context.Context, driverId int) error {
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("%s/%d/heartbeat", c.DriverUrl, driverId), nil, nil, http.StatusAccepted, nil)
+}
+
+/////////////////////////
+//                     //
+//     Onboarding      //
+//                     //
+/////////////////////////
+
+//Fails with 404 Not Found if the driver has never been suspended
+func (c *Client) GetDriverSuspension(ctx context.Context, driverId int) (models.Suspension, error) {
+	var suspension models.Suspension
+	err := c.get(ctx, fmt.Sprintf("%s/%d/suspension", c.DriverUrl, driverId), &suspension)
+	return suspension, err
+}
+
+//Uploads one of the documents the driver needs to be approved, replacing any uploaded before
+func (c *Client) UploadDriverDocument(ctx context.Context, driverId int, documentType string, contentType string, file io.Reader) (models.Document, error) {
+	var document models.Document
+
+	url := fmt.Sprintf("%s/%d/documents/%s", c.DriverUrl, driverId, neturl.PathEscape(documentType))
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, url, file)
+	if err != nil {
+		return document, err
+	}
+	request.Header = header
+
+	resp, err := c.send(request)
+	if err != nil {
+		return document, err
+	}
+	defer resp.Body.Close()
+
+	err = responseError(resp, http.StatusCreated)
+	if err != nil {
+		return document, err
+	}
+	err = json.NewDecoder(resp.Body).Decode(&document)
+	return document, err
+}
+
+/////////////////////////
+//                     //
+//      Vehicles       //
+//                     //
+/////////////////////////
+
+func (c *Client) GetVehicle(ctx context.Context, id int) (models.Vehicle, error) {
+	var vehicle models.Vehicle
+	err := c.get(ctx, fmt.Sprintf("%s/%d", c.VehicleUrl, id), &vehicle)
+	return vehicle, err
+}
+
+//Returns the vehicles with the given license plate, there is at most one
+func (c *Client) GetVehiclesByPlate(ctx context.Context, licensePlate string) ([]models.Vehicle, error) {
+	var vehicles []models.Vehicle
+	err := c.get(ctx, withQuery(c.VehicleUrl, neturl.Values{"licensePlate": {licensePlate}}), &vehicles)
+	return vehicles, err
+}
+
+func (c *Client) CreateVehicle(ctx context.Context, newVehicle models.Vehicle) (models.Vehicle, error) {
+	var vehicle models.Vehicle
+	err := c.do(ctx, http.MethodPost, c.VehicleUrl, newVehicle, nil, http.StatusCreated, &vehicle)
+	return vehicle, err
+}
+
+func (c *Client) GetDriverVehicles(ctx context.Context, driverId int) ([]models.Vehicle, error) {
+	var vehicles []models.Vehicle
+	err := c.get(ctx, fmt.Sprintf("%s/%d/vehicles", c.DriverUrl, driverId), &vehicles)
+	return vehicles, err
+}
+
+//Registers the vehicle to the driver so that they can drive it
+func (c *Client) AddDriverVehicle(ctx context.Context, driverId int, vehicleId int) (models.Vehicle, error) {
+	var vehicle models.Vehicle
+	url := fmt.Sprintf("%s/%d/vehicles/%d", c.DriverUrl, driverId, vehicleId)
+	err := c.do(ctx, http.MethodPut, url, nil, nil, http.StatusAccepted, &vehicle)
+	return vehicle, err
+}
+
+//Sets which of the driver's vehicles they drive on their next shift
+func (c *Client) SetActiveVehicle(ctx context.Context, driverId int, vehicleId int) (models.Vehicle, error) {
+	var vehicle models.Vehicle
+	url := fmt.Sprintf("%s/%d/activeVehicle", c.DriverUrl, driverId)
+	err := c.do(ctx, http.MethodPut, url, map[string]int{"VehicleId": vehicleId}, nil, http.StatusAccepted, &vehicle)
+	return vehicle, err
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	neturl "net/url"
+
+	"shared/models"
+)
+
+/*
+This function returns the /readyz of the microservice serving resourceUrl.
+If it isn't ready, the returned Readiness says why along with the error
+*/
+func (c *Client) GetReadiness(ctx context.Context, resourceUrl string) (models.Readiness, error) {
+	var readiness models.Readiness
+
+	parsedUrl, err := neturl.Parse(resourceUrl)
+	if err != nil {
+		return readiness, err
+	}
+	parsedUrl.Path = "/readyz"
+	parsedUrl.RawQuery = ""
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, parsedUrl.String(), nil)
+	if err != nil {
+		return readiness, err
+	}
+	resp, err := c.send(request)
+	if err != nil {
+		return readiness, err
+	}
+	defer resp.Body.Close()
+
+	//503 Service Unavailable comes with the readiness too
+	json.NewDecoder(resp.Body).Decode(&readiness)
+	if resp.StatusCode != http.StatusOK {
+		return readiness, &Error{StatusCode: resp.StatusCode, Message: resp.Status}
+	}
+	return readiness, nil
+}
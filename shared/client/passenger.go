@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+
+	"shared/models"
+)
+
+/////////////////////////
+//                     //
+//      Passenger      //
+//                     //
+/////////////////////////
+
+func (c *Client) GetPassenger(ctx context.Context, id int) (models.Passenger, error) {
+	var passenger models.Passenger
+	err := c.get(ctx, fmt.Sprintf("%s/%d", c.PassengerUrl, id), &passenger)
+	return passenger, err
+}
+
+//Returns the passengers with the given email, there is at most one
+func (c *Client) GetPassengersByEmail(ctx context.Context, email string) ([]models.Passenger, error) {
+	var passengers []models.Passenger
+	err := c.get(ctx, withQuery(c.PassengerUrl, neturl.Values{"email": {email}}), &passengers)
+	return passengers, err
+}
+
+func (c *Client) CreatePassenger(ctx context.Context, newPassenger models.Passenger) (models.Passenger, error) {
+	var passenger models.Passenger
+	err := c.do(ctx, http.MethodPost, c.PassengerUrl, newPassenger, nil, http.StatusCreated, &passenger)
+	return passenger, err
+}
+
+//Fails with 412 Precondition Failed if the passenger has changed since newPassenger.Version
+func (c *Client) UpdatePassenger(ctx context.Context, newPassenger models.Passenger) (models.Passenger, error) {
+	var passenger models.Passenger
+	err := c.putIfMatch(ctx, fmt.Sprintf("%s/%d", c.PassengerUrl, newPassenger.Id), newPassenger, newPassenger.Version, &passenger)
+	return passenger, err
+}
+
+/////////////////////////
+//                     //
+//   Payment Methods   //
+//                     //
+/////////////////////////
+
+func (c *Client) GetPaymentMethods(ctx context.Context, passengerId int) ([]models.PaymentMethod, error) {
+	var paymentMethods []models.PaymentMethod
+	err := c.get(ctx, fmt.Sprintf("%s/%d/paymentMethods", c.PassengerUrl, passengerId), &paymentMethods)
+	return paymentMethods, err
+}
+
+//Saves the card in newPaymentMethod.CardNumber for newPaymentMethod.PassengerId
+func (c *Client) CreatePaymentMethod(ctx context.Context, newPaymentMethod models.PaymentMethod) (models.PaymentMethod, error) {
+	var paymentMethod models.PaymentMethod
+	url := fmt.Sprintf("%s/%d/paymentMethods", c.PassengerUrl, newPaymentMethod.PassengerId)
+	err := c.do(ctx, http.MethodPost, url, newPaymentMethod, nil, http.StatusCreated, &paymentMethod)
+	return paymentMethod, err
+}
+
+func (c *Client) DeletePaymentMethod(ctx context.Context, passengerId int, id int) error {
+	url := fmt.Sprintf("%s/%d/paymentMethods/%d", c.PassengerUrl, passengerId, id)
+	return c.do(ctx, http.MethodDelete, url, nil, nil, http.StatusAccepted, nil)
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+
+	"shared/models"
+)
+
+/////////////////////////
+//                     //
+//        Trip         //
+//                     //
+/////////////////////////
+
+func (c *Client) GetTrip(ctx context.Context, id int) (models.Trip, error) {
+	var trip models.Trip
+	err := c.get(ctx, fmt.Sprintf("%s/%d", c.TripUrl, id), &trip)
+	return trip, err
+}
+
+func (c *Client) GetPassengerTrips(ctx context.Context, passengerId int) ([]models.Trip, error) {
+	var trips []models.Trip
+	err := c.get(ctx, withQuery(c.TripUrl, neturl.Values{"passengerId": {strconv.Itoa(passengerId)}}), &trips)
+	return trips, err
+}
+
+func (c *Client) GetDriverTrips(ctx context.Context, driverId int) ([]models.Trip, error) {
+	var trips []models.Trip
+	err := c.get(ctx, withQuery(c.TripUrl, neturl.Values{"driverId": {strconv.Itoa(driverId)}}), &trips)
+	return trips, err
+}
+
+/*
+The passenger's trips with the names of who they were with and their vehicle's plate,
+put together by the gateway so TripUrl has to be the gateway's
+*/
+func (c *Client) GetPassengerTripDetails(ctx context.Context, passengerId int) ([]models.TripDetails, error) {
+	var details []models.TripDetails
+	err := c.get(ctx, withQuery(c.TripUrl+"/details", neturl.Values{"passengerId": {strconv.Itoa(passengerId)}}), &details)
+	return details, err
+}
+
+/*
+Books a trip, paid with newTrip.PaymentMethodToken.
+Fails with 422 Unprocessable Entity if the promo code can't be used
+and 402 Payment Required if the fare can't be held
+*/
+func (c *Client) CreateTrip(ctx context.Context, newTrip models.Trip) (models.Trip, error) {
+	var trip models.Trip
+	err := c.do(ctx, http.MethodPost, c.TripUrl, newTrip, nil, http.StatusCreated, &trip)
+	return trip, err
+}
+
+//Fails with 412 Precondition Failed if the trip has changed since newTrip.Version
+func (c *Client) UpdateTrip(ctx context.Context, newTrip models.Trip) (models.Trip, error) {
+	var trip models.Trip
+	err := c.putIfMatch(ctx, fmt.Sprintf("%s/%d", c.TripUrl, newTrip.Id), newTrip, newTrip.Version, &trip)
+	return trip, err
+}
+
+/////////////////////////
+//                     //
+//        Fares        //
+//                     //
+/////////////////////////
+
+//Trip to quote a fare for with GetFare
+type FareRequest struct {
+	PassengerId   int
+	PickUpPostal  int
+	DropOffPostal int
+	RideClass     string
+	PromoCode     string //optional
+}
+
+//Returns what the passenger would pay for the trip without booking it
+func (c *Client) GetFare(ctx context.Context, fareRequest FareRequest) (models.FareQuote, error) {
+	query := neturl.Values{
+		"passengerId":   {strconv.Itoa(fareRequest.PassengerId)},
+		"pickUpPostal":  {strconv.Itoa(fareRequest.PickUpPostal)},
+		"dropOffPostal": {strconv.Itoa(fareRequest.DropOffPostal)},
+		"rideClass":     {fareRequest.RideClass},
+	}
+	if fareRequest.PromoCode != "" {
+		query.Set("promoCode", fareRequest.PromoCode)
+	}
+
+	var quote models.FareQuote
+	err := c.get(ctx, withQuery(c.FareUrl, query), &quote)
+	return quote, err
+}
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"shared/logging"
+	"shared/service"
+	"shared/settings"
+	"shared/tracing"
+)
+
+/*
+This function connects to the database at dsn, with rows versioned
+and database calls traced
+*/
+func Open(dsn string) *gorm.DB {
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	if err != nil {
+		panic("Failed to connect to database with DSN: " + settings.RedactDsn(dsn))
+	}
+
+	registerVersionCallback(db)
+	tracing.RegisterCallbacks(db)
+	return db
+}
+
+func Close(db *gorm.DB) {
+	sqlDb, err := db.DB()
+	if err != nil {
+		return
+	}
+	err = sqlDb.Close()
+	if err != nil {
+		logging.Error("Failed to close database", map[string]interface{}{"error": err.Error()})
+	}
+}
+
+/*
+This function returns whether a row of model with the given value for the given fieldName exists
+*/
+func ExistInDb(db *gorm.DB, model interface{}, fieldName string, value interface{}) bool {
+	//if the value doesn't exist, db.First returns a ErrRecordNotFound
+	err := db.Where(fieldName+" = ?", value).First(model).Error
+	return err != gorm.ErrRecordNotFound
+}
+
+/*
+This function returns the query to list rows with, deleted rows are only included
+when an admin asks for them with includeDeleted
+*/
+func ListQuery(ctx context.Context, db *gorm.DB, includeDeleted bool) (*gorm.DB, error) {
+	db = db.WithContext(ctx)
+	if !includeDeleted {
+		return db, nil
+	}
+	err := service.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return db.Unscoped(), nil
+}
+
+/*
+This function returns whether err is from writing a value that a unique index already has
+*/
+func IsDuplicateKeyError(err error) bool {
+	var mysqlErr *mysqlDriver.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+/*
+Rows with a Version field are versioned, the version is sent as the ETag and PUT and DELETE
+need it back in If-Match so that a client can't overwrite a change it hasn't seen.
+This function bumps the version on every map or column update,
+updates with a struct have to set the Version themselves
+*/
+func registerVersionCallback(db *gorm.DB) {
+	db.Callback().Update().Before("gorm:update").Register("version:bump", func(tx *gorm.DB) {
+		if tx.Statement.Schema == nil || tx.Statement.Schema.LookUpField("Version") == nil {
+			return
+		}
+
+		updates, ok := tx.Statement.Dest.(map[string]interface{})
+		if !ok {
+			return
+		}
+		_, hasField := updates["Version"]
+		_, hasColumn := updates["version"]
+		if !hasField && !hasColumn {
+			updates["version"] = gorm.Expr("version + 1")
+		}
+	})
+}
@@ -0,0 +1,392 @@
+package database
+
+import (
+	"bufio"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+/*
+Schema changes are SQL files in migrationsDir named "<version>_<name>.up.sql",
+each with a "<version>_<name>.down.sql" that undoes it.
+Versions start at 1 and go up by 1, applied versions are kept in schema_migrations.
+A migration can also have a "<version>_<name>.check.sql" of SELECTs of rows it can't be applied to,
+e.g. duplicates that a unique index would fail on. Rows they find are printed and stop the migration
+*/
+const migrationsDir = "migrations"
+
+type Migration struct {
+	Version   int
+	Name      string
+	UpFile    string
+	DownFile  string
+	CheckFile string //"" if the migration has no check
+}
+
+type SchemaMigration struct {
+	Service   string
+	Version   int
+	Name      string
+	AppliedAt time.Time
+}
+
+//The services share a database, so each records its migrations under its own service name
+type migrator struct {
+	db      *gorm.DB
+	service string
+}
+
+/*
+This function runs "migrate up", "migrate down" or "migrate status"
+given the arguments after "migrate"
+*/
+func RunMigrateCommand(db *gorm.DB, service string, args []string) {
+	m := migrator{db: db, service: service}
+
+	if len(args) != 1 {
+		fmt.Println("Usage: migrate up|down|status")
+		os.Exit(2)
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		log.Fatal("Could not load migrations: " + err.Error())
+	}
+
+	switch args[0] {
+	case "up":
+		err = m.migrateUp(migrations)
+	case "down":
+		err = m.migrateDown(migrations)
+	case "status":
+		err = m.printMigrationStatus(migrations)
+	default:
+		fmt.Println("Usage: migrate up|down|status")
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+/*
+This function stops the service if the database isn't at the latest migration,
+rather than run against a schema it wasn't written for. It returns the latest version
+*/
+func CheckSchemaVersion(db *gorm.DB, service string) int {
+	migrations, err := loadMigrations()
+	if err != nil {
+		log.Fatal("Could not load migrations: " + err.Error())
+	}
+
+	version, err := SchemaVersion(db, service)
+	if err != nil {
+		log.Fatal("Could not get schema version: " + err.Error())
+	}
+
+	latest := len(migrations)
+	if version < latest {
+		log.Fatalf("Database schema is at version %d but %d is needed, run \"go run . migrate up\" first", version, latest)
+	}
+	if version > latest {
+		log.Fatalf("Database schema is at version %d, which is newer than this build knows (%d)", version, latest)
+	}
+	return latest
+}
+
+func (m migrator) migrateUp(migrations []Migration) error {
+	version, err := SchemaVersion(m.db, m.service)
+	if err != nil {
+		return err
+	}
+
+	if version >= len(migrations) {
+		fmt.Printf("Already at the latest version %d\n", version)
+		return nil
+	}
+
+	for _, migration := range migrations[version:] {
+		if migration.CheckFile != "" {
+			err = m.checkMigration(migration)
+			if err != nil {
+				return err
+			}
+		}
+
+		fmt.Printf("Applying %04d_%s...\n", migration.Version, migration.Name)
+		err = m.execSqlFile(migration.UpFile)
+		if err != nil {
+			return fmt.Errorf("migration %d failed, fix the database by hand before retrying: %v", migration.Version, err)
+		}
+
+		err = m.db.Create(&SchemaMigration{
+			Service:   m.service,
+			Version:   migration.Version,
+			Name:      migration.Name,
+			AppliedAt: time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Migrated to version %d\n", len(migrations))
+	return nil
+}
+
+//Undoes the latest migration only, run it again to go further down
+func (m migrator) migrateDown(migrations []Migration) error {
+	version, err := SchemaVersion(m.db, m.service)
+	if err != nil {
+		return err
+	}
+
+	if version == 0 {
+		fmt.Println("No migrations to undo")
+		return nil
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("version %d is newer than this build knows", version)
+	}
+
+	migration := migrations[version-1]
+	fmt.Printf("Undoing %04d_%s...\n", migration.Version, migration.Name)
+	err = m.execSqlFile(migration.DownFile)
+	if err != nil {
+		return fmt.Errorf("undoing migration %d failed, fix the database by hand before retrying: %v", migration.Version, err)
+	}
+
+	err = m.db.Where("service = ? AND version = ?", m.service, migration.Version).Delete(&SchemaMigration{}).Error
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Migrated to version %d\n", version-1)
+	return nil
+}
+
+func (m migrator) printMigrationStatus(migrations []Migration) error {
+	err := createSchemaMigrationsTable(m.db)
+	if err != nil {
+		return err
+	}
+
+	var applied []SchemaMigration
+	m.db.Where("service = ?", m.service).Find(&applied)
+	appliedAt := map[int]time.Time{}
+	for _, schemaMigration := range applied {
+		appliedAt[schemaMigration.Version] = schemaMigration.AppliedAt
+	}
+
+	for _, migration := range migrations {
+		status := "pending"
+		if at, ok := appliedAt[migration.Version]; ok {
+			status = "applied " + at.Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%04d_%s: %s\n", migration.Version, migration.Name, status)
+	}
+	return nil
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func loadMigrations() ([]Migration, error) {
+	upFiles, err := filepath.Glob(filepath.Join(migrationsDir, "*.up.sql"))
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := []Migration{}
+	for _, upFile := range upFiles {
+		baseName := strings.TrimSuffix(filepath.Base(upFile), ".up.sql")
+		parts := strings.SplitN(baseName, "_", 2)
+		version, err := strconv.Atoi(parts[0])
+		if err != nil || len(parts) != 2 {
+			return nil, errors.New("migration file names must look like 0001_name.up.sql: " + upFile)
+		}
+
+		downFile := filepath.Join(migrationsDir, baseName+".down.sql")
+		if _, err := os.Stat(downFile); err != nil {
+			return nil, errors.New("missing " + downFile)
+		}
+
+		checkFile := filepath.Join(migrationsDir, baseName+".check.sql")
+		if _, err := os.Stat(checkFile); err != nil {
+			checkFile = ""
+		}
+
+		migrations = append(migrations, Migration{
+			Version:   version,
+			Name:      parts[1],
+			UpFile:    upFile,
+			DownFile:  downFile,
+			CheckFile: checkFile,
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			return nil, fmt.Errorf("migration versions must go 1, 2, 3..., found %d after %d", migration.Version, i)
+		}
+	}
+
+	return migrations, nil
+}
+
+//Returns the latest migration of service applied to the database, 0 if there are none
+func SchemaVersion(db *gorm.DB, service string) (int, error) {
+	err := createSchemaMigrationsTable(db)
+	if err != nil {
+		return 0, err
+	}
+
+	var version int
+	err = db.Model(&SchemaMigration{}).Where("service = ?", service).
+		Select("COALESCE(MAX(version), 0)").Scan(&version).Error
+	return version, err
+}
+
+func createSchemaMigrationsTable(db *gorm.DB) error {
+	return db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		service varchar(32) NOT NULL,
+		version bigint NOT NULL,
+		name varchar(255) NOT NULL,
+		applied_at datetime(3) NOT NULL,
+		PRIMARY KEY (service, version)
+	)`).Error
+}
+
+/*
+This function runs each of the migration's check queries and prints the rows they find, one per line,
+returning an error if there are any so that they can be fixed before migrating
+*/
+func (m migrator) checkMigration(migration Migration) error {
+	queries, err := readSqlFile(migration.CheckFile)
+	if err != nil {
+		return err
+	}
+
+	found := 0
+	for _, query := range queries {
+		queryFound, err := m.printCheckRows(migration, query, found)
+		if err != nil {
+			return fmt.Errorf("checking migration %d failed: %v", migration.Version, err)
+		}
+		found += queryFound
+	}
+
+	if found > 0 {
+		return fmt.Errorf("migration %04d_%s can't be applied until the rows above are fixed by hand, then run migrate up again", migration.Version, migration.Name)
+	}
+	return nil
+}
+
+//Prints the rows the check query finds after the ones already found, and returns how many there were
+func (m migrator) printCheckRows(migration Migration, query string, alreadyFound int) (int, error) {
+	rows, err := m.db.Raw(query).Rows()
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	found := 0
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		err = rows.Scan(pointers...)
+		if err != nil {
+			return found, err
+		}
+
+		if alreadyFound+found == 0 {
+			fmt.Printf("Checking %04d_%s found:\n", migration.Version, migration.Name)
+		}
+		fields := make([]string, len(columns))
+		for i, column := range columns {
+			fields[i] = column + "=" + values[i].String
+		}
+		fmt.Println("  " + strings.Join(fields, ", "))
+		found++
+	}
+	return found, rows.Err()
+}
+
+//This function runs each statement in a SQL file
+func (m migrator) execSqlFile(path string) error {
+	statements, err := readSqlFile(path)
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range statements {
+		err = m.db.Exec(statement).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+This function returns the statements in a SQL file. Statements end with ";" at the end of a line
+and lines starting with "--" are comments
+*/
+func readSqlFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var statements []string
+	var statement strings.Builder
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "--") {
+			continue
+		}
+
+		if !strings.HasSuffix(line, ";") {
+			statement.WriteString(line)
+			statement.WriteString("\n")
+			continue
+		}
+
+		statement.WriteString(strings.TrimSuffix(line, ";"))
+		statements = append(statements, statement.String())
+		statement.Reset()
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(statement.String()) != "" {
+		return nil, errors.New("last statement is missing a \";\"")
+	}
+	return statements, nil
+}
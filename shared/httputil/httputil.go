@@ -0,0 +1,101 @@
+package httputil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"shared/service"
+)
+
+//Helpers for writing the microservices' HTTP handlers, errors are responded with as a JSON string
+
+func RespondWith(w http.ResponseWriter, statusCode int, data interface{}) {
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(data)
+}
+
+/*
+This function responds with the status and message of err if it's a service.Error,
+and with 500 Internal Server Error if not
+*/
+func RespondWithError(w http.ResponseWriter, err error) {
+	if serviceErr, ok := err.(*service.Error); ok {
+		RespondWith(w, serviceErr.Status, serviceErr.Message)
+		return
+	}
+	RespondWith(w, http.StatusInternalServerError, err.Error())
+}
+
+//Returns whether the request's adminPassword query parameter is adminPassword
+func HasValidAdminPass(r *http.Request, adminPassword string) bool {
+	urlParams := r.URL.Query()
+	if queryPassword, ok := urlParams["adminPassword"]; ok {
+		if queryPassword[0] == adminPassword {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+This function lets the service functions called by a request know whether it had the admin password,
+see service.RequireAdmin
+*/
+func AdminMiddleware(adminPassword string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := service.WithAdmin(r.Context(), HasValidAdminPass(r, adminPassword))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+/////////////////////////
+//                     //
+//      Versioning     //
+//                     //
+/////////////////////////
+
+//Versioned rows send their version as the ETag, PUT and DELETE need it back in If-Match, see service.IfMatch
+
+func SetETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", fmt.Sprintf("\"%d\"", version))
+}
+
+//Returns the versions that the request's If-Match header expects
+func IfMatch(r *http.Request) service.IfMatch {
+	return service.IfMatchHeader(r.Header.Get("If-Match"))
+}
+
+/////////////////////////
+//                     //
+//       Routes        //
+//                     //
+/////////////////////////
+
+//Returns the template of the route the request matched, e.g. "/passengers/{id}", or "unknown"
+func RouteTemplate(r *http.Request) string {
+	route := "unknown"
+	if currentRoute := mux.CurrentRoute(r); currentRoute != nil {
+		route, _ = currentRoute.GetPathTemplate()
+	}
+	return route
+}
+
+//Keeps the status code of a response so that it can be logged and counted
+type StatusRecorder struct {
+	http.ResponseWriter
+	Status int
+}
+
+func NewStatusRecorder(w http.ResponseWriter) *StatusRecorder {
+	return &StatusRecorder{ResponseWriter: w, Status: http.StatusOK}
+}
+
+func (recorder *StatusRecorder) WriteHeader(status int) {
+	recorder.Status = status
+	recorder.ResponseWriter.WriteHeader(status)
+}
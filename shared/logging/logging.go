@@ -0,0 +1,157 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/trace"
+
+	"shared/httputil"
+)
+
+//Identifies everything done for one user action across the microservices
+const RequestIdHeader = "X-Request-ID"
+
+type contextKey string
+
+const requestIdKey contextKey = "requestId"
+
+//Request IDs from clients are only kept if they look like one, so that they can't mess up the logs
+var validRequestId = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+//Logged with every entry, set by Init
+var serviceName string
+
+var logMutex sync.Mutex
+
+//Sets the name of the service that logs are from
+func Init(name string) {
+	serviceName = name
+}
+
+/*
+This function writes a log as a JSON object on its own line,
+with the time, level, service and msg along with the given fields
+*/
+func logJson(level string, msg string, fields map[string]interface{}) {
+	entry := map[string]interface{}{}
+	for key, value := range fields {
+		entry[key] = value
+	}
+	entry["time"] = time.Now().UTC().Format(time.RFC3339Nano)
+	entry["level"] = level
+	entry["service"] = serviceName
+	entry["msg"] = msg
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		line, _ = json.Marshal(map[string]string{"level": "error", "service": serviceName, "msg": "Unloggable fields: " + err.Error()})
+	}
+
+	logMutex.Lock()
+	defer logMutex.Unlock()
+	os.Stdout.Write(append(line, '\n'))
+}
+
+func Info(msg string, fields map[string]interface{}) {
+	logJson("info", msg, fields)
+}
+
+func Error(msg string, fields map[string]interface{}) {
+	logJson("error", msg, fields)
+}
+
+/*
+This function logs every request the router matches,
+under the X-Request-ID it came with or a new one, which is also sent back
+*/
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := ValidRequestId(r.Header.Get(RequestIdHeader))
+		w.Header().Set(RequestIdHeader, id)
+		r = r.WithContext(WithRequestId(r.Context(), id))
+
+		recorder := httputil.NewStatusRecorder(w)
+		start := time.Now()
+		next.ServeHTTP(recorder, r)
+
+		fields := map[string]interface{}{
+			"requestId":  id,
+			"method":     r.Method,
+			"route":      httputil.RouteTemplate(r),
+			"path":       r.URL.Path,
+			"status":     recorder.Status,
+			"durationMs": float64(time.Since(start).Microseconds()) / 1000,
+		}
+		if spanContext := trace.SpanContextFromContext(r.Context()); spanContext.IsValid() {
+			fields["traceId"] = spanContext.TraceID().String()
+		}
+		if recorder.Status >= 500 {
+			Error("request", fields)
+		} else {
+			Info("request", fields)
+		}
+	})
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+//Returns the ID of the request being handled, set by Middleware
+func RequestId(r *http.Request) string {
+	return ContextRequestId(r.Context())
+}
+
+//Returns the request ID that ctx is for, "" if it isn't for a request
+func ContextRequestId(ctx context.Context) string {
+	id, _ := ctx.Value(requestIdKey).(string)
+	return id
+}
+
+func WithRequestId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIdKey, id)
+}
+
+//Returns id if it looks like a request ID, or a new one if not
+func ValidRequestId(id string) string {
+	if !validRequestId.MatchString(id) {
+		return NewRequestId()
+	}
+	return id
+}
+
+func NewRequestId() string {
+	randomBytes := make([]byte, 16)
+	rand.Read(randomBytes)
+	return hex.EncodeToString(randomBytes)
+}
+
+/*
+This function makes a GET to another microservice with the given request ID and ctx's trace,
+so that what it does is logged under the same ID and traced as part of ctx
+*/
+func GetWithRequestId(ctx context.Context, url string, id string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set(RequestIdHeader, id)
+
+	return TracedHttpClient().Do(request)
+}
+
+//Returns a client for calling other microservices that traces its requests as part of their context
+func TracedHttpClient() *http.Client {
+	return &http.Client{Timeout: 5 * time.Second, Transport: otelhttp.NewTransport(http.DefaultTransport)}
+}
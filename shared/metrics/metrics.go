@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gorm.io/gorm"
+
+	"shared/httputil"
+)
+
+var httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "http_requests_total",
+	Help: "HTTP requests by route, method and status code",
+}, []string{"route", "method", "status"})
+
+var httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "http_request_duration_seconds",
+	Help:    "How long HTTP requests took to handle by route and method",
+	Buckets: prometheus.DefBuckets,
+}, []string{"route", "method"})
+
+/*
+This function serves the metrics on /metrics and counts every request the router matches,
+along with db's connection pool, unless db is nil, and the service's own collectors
+*/
+func Init(router *mux.Router, db *gorm.DB, serviceCollectors ...prometheus.Collector) {
+	if db != nil {
+		sqlDb, err := db.DB()
+		if err == nil {
+			prometheus.MustRegister(collectors.NewDBStatsCollector(sqlDb, "hytchhyke"))
+		}
+	}
+	prometheus.MustRegister(httpRequests, httpRequestDuration)
+	prometheus.MustRegister(serviceCollectors...)
+
+	router.Use(middleware)
+	router.Handle("/metrics", promhttp.Handler()).Methods("GET")
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+//Routes are labelled by their template, e.g. "/passengers/{id}", so that ids don't make new series
+func middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := httputil.RouteTemplate(r)
+		recorder := httputil.NewStatusRecorder(w)
+		start := time.Now()
+		next.ServeHTTP(recorder, r)
+
+		httpRequestDuration.WithLabelValues(route, r.Method).Observe(time.Since(start).Seconds())
+		httpRequests.WithLabelValues(route, r.Method, strconv.Itoa(recorder.Status)).Inc()
+	})
+}
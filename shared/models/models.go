@@ -0,0 +1,180 @@
+package models
+
+import "time"
+
+//Models as sent and returned by the microservices, described in each one's /openapi.json.
+//The microservices respond with these, so a field that is renamed or removed on either side doesn't compile
+
+/////////////////////////
+//                     //
+//      Passenger      //
+//                     //
+/////////////////////////
+
+type Passenger struct {
+	Id        int
+	FirstName string
+	LastName  string
+	MobileNo  int
+	Email     string
+	Version   int //sent as If-Match when updating
+
+	DeletedAt    *time.Time
+	AnonymisedAt *time.Time
+}
+
+//A card saved by a passenger, only Last4 and Token are kept once created
+type PaymentMethod struct {
+	Id          int
+	PassengerId int
+	Last4       string
+	ExpiryMonth int
+	ExpiryYear  int
+	Token       string
+	CardNumber  string `json:",omitempty"` //only sent when creating
+}
+
+/////////////////////////
+//                     //
+//       Driver        //
+//                     //
+/////////////////////////
+
+type Driver struct {
+	Id           int
+	FirstName    string
+	LastName     string
+	MobileNo     int
+	Email        string
+	CarLicenseNo string //plate of the active vehicle
+	Available    bool   //approved, online and not on a trip
+
+	ActiveVehicleId int
+	Online          bool
+	ActiveTripId    int
+	LastActiveAt    time.Time
+
+	OnboardingStatus string //"pending", "under_review", "approved" or "suspended"
+	OnboardingNote   string //why the driver was sent back to pending
+
+	Version int //sent as If-Match when updating
+
+	DeletedAt    *time.Time
+	AnonymisedAt *time.Time
+}
+
+type Vehicle struct {
+	Id           int
+	LicensePlate string
+	Make         string
+	Model        string
+	Colour       string
+	Seats        int    //passenger seats, excluding the driver
+	VehicleClass string //"standard", "xl", "premium" or "wheelchair"
+	Version      int    //sent as If-Match when updating
+}
+
+//A period of time a driver was online for
+type Shift struct {
+	Id        int
+	DriverId  int
+	VehicleId int
+	StartedAt time.Time
+	EndedAt   *time.Time
+	EndReason string //"offline", "inactivity" or "suspended"
+}
+
+type Suspension struct {
+	Id             int
+	DriverId       int
+	ReasonCode     string
+	Note           string
+	EffectiveFrom  time.Time //now if not given
+	EffectiveUntil *time.Time
+	Applied        bool
+	ReinstatedAt   *time.Time
+}
+
+//A document uploaded by a driver for onboarding
+type Document struct {
+	Id           int
+	DriverId     int
+	DocumentType string //"licence", "vehicle_registration" or "insurance"
+	ContentType  string
+	Size         int64
+	UploadedAt   time.Time
+}
+
+/////////////////////////
+//                     //
+//        Trip         //
+//                     //
+/////////////////////////
+
+type Trip struct {
+	Id              int
+	PassengerId     int
+	DriverId        int
+	VehicleId       int
+	PickUpPostal    int
+	DropOffPostal   int
+	RideClass       string //"standard", "xl", "premium" or "wheelchair"
+	PartySize       int
+	Fare            int //in cents, after Discount
+	Discount        int //in cents
+	PromoCode       string
+	SurgeMultiplier float64
+	Status          string //"waiting", "driving", "finished" or "cancelled"
+	CreatedAt       time.Time
+	Version         int //sent as If-Match when updating
+
+	DeletedAt *time.Time
+
+	PaymentMethodToken string `json:",omitempty"` //only sent when booking
+}
+
+//Breakdown of what a passenger pays for a trip
+type FareQuote struct {
+	SurgeMultiplier float64
+	FullFare        int
+	Discount        int
+	Fare            int
+	PromoCode       string
+}
+
+/*
+A trip with who and what it was with, put together by the gateway from all 3 microservices.
+Whatever couldn't be got is left empty, with why in Warnings
+*/
+type TripDetails struct {
+	Trip
+	PassengerName      string
+	DriverName         string
+	DriverMobileNo     int
+	VehiclePlate       string
+	VehicleDescription string   //e.g. Red Toyota Corolla
+	Warnings           []string `json:",omitempty"`
+}
+
+/////////////////////////
+//                     //
+//       Health        //
+//                     //
+/////////////////////////
+
+type BuildInfo struct {
+	Version   string
+	Commit    string
+	GoVersion string
+}
+
+type Readiness struct {
+	Status              string            //"ready" or "not ready"
+	Database            string            `json:",omitempty"` //"ok" or "unavailable", the gateway has none
+	SchemaVersion       int               `json:",omitempty"`
+	LatestSchemaVersion int               `json:",omitempty"`
+	Backends            map[string]string `json:",omitempty"` //only from the gateway, "ok" or why each microservice isn't ready
+	Build               BuildInfo
+	StartedAt           time.Time
+	Uptime              string
+}
@@ -0,0 +1,141 @@
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gorilla/mux"
+
+	"shared/httputil"
+)
+
+var openApiDoc *openapi3.T
+var openApiJson []byte
+
+/*
+This function loads openApiFile, which describes every route, serves it as JSON on /openapi.json
+and validates every request the router matches against it
+*/
+func Init(router *mux.Router, openApiFile string) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromFile(openApiFile)
+	if err != nil {
+		log.Fatal("Could not load " + openApiFile + ": " + err.Error())
+	}
+	err = doc.Validate(loader.Context)
+	if err != nil {
+		log.Fatal("Invalid " + openApiFile + ": " + err.Error())
+	}
+
+	openApiDoc = doc
+	openApiJson, err = json.Marshal(doc)
+	if err != nil {
+		log.Fatal("Could not convert " + openApiFile + " to JSON: " + err.Error())
+	}
+
+	router.Use(validationMiddleware)
+	router.HandleFunc("/openapi.json", getOpenApi).Methods("GET")
+}
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+func getOpenApi(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(openApiJson)
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+/*
+This function responds with 400 Bad Request to requests whose parameters or JSON body
+don't match the route's operation in the OpenAPI document
+*/
+func validationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pathItem := openApiDoc.Paths.Find(httputil.RouteTemplate(r))
+		if pathItem == nil || pathItem.GetOperation(r.Method) == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		operation := pathItem.GetOperation(r.Method)
+
+		//bodies that aren't JSON, like documents, are streamed to the handler instead of read here
+		jsonBody := operation.RequestBody != nil && operation.RequestBody.Value.Content.Get("application/json") != nil
+		if jsonBody && r.Header.Get("Content-Type") == "" {
+			r.Header.Set("Content-Type", "application/json")
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: mux.Vars(r),
+			Route: &routers.Route{
+				Spec:      openApiDoc,
+				Path:      httputil.RouteTemplate(r),
+				PathItem:  pathItem,
+				Method:    r.Method,
+				Operation: operation,
+			},
+			Options: &openapi3filter.Options{ExcludeRequestBody: !jsonBody},
+		}
+		err := openapi3filter.ValidateRequest(r.Context(), input)
+		if err != nil {
+			httputil.RespondWith(w, http.StatusBadRequest, "Invalid request: "+validationErrorMessage(err))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+//Says which parameter or body field is invalid and why, without the schema the error comes with
+func validationErrorMessage(err error) string {
+	var requestErr *openapi3filter.RequestError
+	if !errors.As(err, &requestErr) {
+		return err.Error()
+	}
+
+	where := "body"
+	if requestErr.Parameter != nil {
+		where = requestErr.Parameter.Name
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(requestErr.Err, &schemaErr) {
+		field := schemaErr.JSONPointer()
+		//allOf errors don't have a reason, the error from the schema that failed does
+		for schemaErr.Reason == "" && errors.As(schemaErr.Origin, &schemaErr) {
+			field = append(field, schemaErr.JSONPointer()...)
+		}
+		if len(field) > 0 {
+			where += " field " + strings.Join(field, ".")
+		}
+		return where + ": " + schemaErr.Reason
+	}
+	var parseErr *openapi3filter.ParseError
+	if errors.As(requestErr.Err, &parseErr) && parseErr.Value != nil && parseErr.Reason != "" {
+		return fmt.Sprintf("%s: %v is %s", where, parseErr.Value, parseErr.Reason)
+	}
+	if requestErr.Err == openapi3filter.ErrInvalidRequired {
+		return where + " is required"
+	}
+	if requestErr.Err != nil {
+		return where + ": " + requestErr.Err.Error()
+	}
+	return where + ": " + requestErr.Reason
+}
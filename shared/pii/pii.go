@@ -0,0 +1,223 @@
+package pii
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"shared/database"
+)
+
+//Encrypted values are stored as "pii:<key id>:<base64 of nonce and ciphertext>"
+const piiPrefix = "pii:"
+
+var piiKeys map[string]cipher.AEAD
+var currentPiiKeyId string
+var emailIndexKey []byte
+
+/*
+This function loads the keys in PII_KEYS, a comma separated list of "<key id>:<base64 key>"
+with 32 byte keys. The first key encrypts new values and the rest only decrypt,
+so keys are rotated by adding a new key at the front.
+PII_INDEX_KEY keys the blind index used to look up emails
+*/
+func Init(keys string, indexKeyBase64 string) {
+	piiKeys = map[string]cipher.AEAD{}
+	for i, entry := range strings.Split(keys, ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			log.Fatalf("Invalid PII_KEYS entry %d", i+1)
+		}
+		key, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil || len(key) != 32 {
+			log.Fatalf("PII_KEYS entry %d must be a base64 32 byte key", i+1)
+		}
+
+		block, _ := aes.NewCipher(key)
+		piiKeys[parts[0]], _ = cipher.NewGCM(block)
+		if i == 0 {
+			currentPiiKeyId = parts[0]
+		}
+	}
+
+	indexKey, err := base64.StdEncoding.DecodeString(indexKeyBase64)
+	if err != nil || len(indexKey) < 32 {
+		log.Fatal("PII_INDEX_KEY must be a base64 key of at least 32 bytes")
+	}
+	emailIndexKey = indexKey
+}
+
+//EncryptedString is a string column that is encrypted at rest
+type EncryptedString string
+
+func (EncryptedString) GormDataType() string {
+	return "string"
+}
+
+func (value EncryptedString) Value() (driver.Value, error) {
+	return encryptPii(string(value))
+}
+
+func (value *EncryptedString) Scan(src interface{}) error {
+	plaintext, err := decryptPii(scannedString(src))
+	*value = EncryptedString(plaintext)
+	return err
+}
+
+//EncryptedInt is an int column, such as a mobile number, that is encrypted at rest
+type EncryptedInt int
+
+func (EncryptedInt) GormDataType() string {
+	return "string"
+}
+
+func (value EncryptedInt) Value() (driver.Value, error) {
+	return encryptPii(strconv.Itoa(int(value)))
+}
+
+func (value *EncryptedInt) Scan(src interface{}) error {
+	plaintext, err := decryptPii(scannedString(src))
+	if err != nil || plaintext == "" {
+		*value = 0
+		return err
+	}
+
+	number, err := strconv.Atoi(plaintext)
+	*value = EncryptedInt(number)
+	return err
+}
+
+/*
+This function encrypts the Email and MobileNo of model's rows written before encryption
+or with a rotated out key, and fills in the email blind index.
+The microservice doesn't start while any row is left unencrypted, so that all personal data is encrypted at rest
+*/
+func Reencrypt(db *gorm.DB, model interface{}) {
+	var rows []piiRow
+	err := db.Unscoped().Model(model).Find(&rows).Error
+	if err != nil {
+		log.Fatal("Could not read personal data to encrypt: " + err.Error())
+	}
+
+	var duplicateIds, undecryptableIds, failedIds []string
+	currentPrefix := piiPrefix + currentPiiKeyId + ":"
+	for _, row := range rows {
+		if strings.HasPrefix(row.Email, currentPrefix) &&
+			strings.HasPrefix(row.MobileNo, currentPrefix) &&
+			row.EmailIndex != "" {
+			continue
+		}
+
+		email, emailErr := decryptPii(row.Email)
+		mobileNo, mobileErr := decryptPii(row.MobileNo)
+		if emailErr != nil || mobileErr != nil {
+			undecryptableIds = append(undecryptableIds, strconv.Itoa(row.Id))
+			continue
+		}
+
+		err = db.Unscoped().Model(model).Where("id = ?", row.Id).Updates(map[string]interface{}{
+			"email":       EncryptedString(email),
+			"mobile_no":   EncryptedString(mobileNo),
+			"email_index": EmailIndex(email),
+		}).Error
+		if database.IsDuplicateKeyError(err) {
+			duplicateIds = append(duplicateIds, strconv.Itoa(row.Id))
+		} else if err != nil {
+			failedIds = append(failedIds, strconv.Itoa(row.Id))
+		}
+	}
+
+	var problems []string
+	if len(duplicateIds) > 0 {
+		problems = append(problems, "rows "+strings.Join(duplicateIds, ", ")+" have the same email as another row, change all but one")
+	}
+	if len(undecryptableIds) > 0 {
+		problems = append(problems, "rows "+strings.Join(undecryptableIds, ", ")+" are encrypted with a key that isn't in PII_KEYS")
+	}
+	if len(failedIds) > 0 {
+		problems = append(problems, "rows "+strings.Join(failedIds, ", ")+" could not be saved")
+	}
+	if len(problems) > 0 {
+		log.Fatal("Personal data could not be encrypted, " + strings.Join(problems, ", and ") + ", then start again")
+	}
+}
+
+//The stored form of the personal data columns
+type piiRow struct {
+	Id         int
+	Email      string
+	MobileNo   string
+	EmailIndex string
+}
+
+/*
+This function returns the blind index of an email, which is the same for the same email
+so it can be looked up without decrypting every row
+*/
+func EmailIndex(email string) string {
+	mac := hmac.New(sha256.New, emailIndexKey)
+	mac.Write([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func encryptPii(plaintext string) (string, error) {
+	aead := piiKeys[currentPiiKeyId]
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return piiPrefix + currentPiiKeyId + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+//Values without the prefix were written before encryption, so they are returned as they are
+func decryptPii(stored string) (string, error) {
+	if !strings.HasPrefix(stored, piiPrefix) {
+		return stored, nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(stored, piiPrefix), ":", 2)
+	if len(parts) != 2 {
+		return "", errors.New("invalid encrypted value")
+	}
+	aead, ok := piiKeys[parts[0]]
+	if !ok {
+		return "", errors.New("unknown key id " + parts[0])
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errors.New("invalid encrypted value")
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	return string(plaintext), err
+}
+
+func scannedString(src interface{}) string {
+	switch value := src.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(value)
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,1273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package driverpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DriverServiceClient is the client API for DriverService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DriverServiceClient interface {
+	ListDrivers(ctx context.Context, in *ListDriversRequest, opts ...grpc.CallOption) (*Drivers, error)
+	GetDriver(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Driver, error)
+	CreateDriver(ctx context.Context, in *Driver, opts ...grpc.CallOption) (*Driver, error)
+	//version has to be the driver's current version, like If-Match
+	UpdateDriver(ctx context.Context, in *Driver, opts ...grpc.CallOption) (*Driver, error)
+	DeleteDriver(ctx context.Context, in *VersionedId, opts ...grpc.CallOption) (*Result, error)
+	RestoreDriver(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error)
+	ExportDriver(ctx context.Context, in *Id, opts ...grpc.CallOption) (*DriverExport, error)
+	AnonymiseDriver(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error)
+	ListDriverVehicles(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Vehicles, error)
+	AddDriverVehicle(ctx context.Context, in *DriverVehicle, opts ...grpc.CallOption) (*Vehicle, error)
+	RemoveDriverVehicle(ctx context.Context, in *DriverVehicle, opts ...grpc.CallOption) (*Result, error)
+	SetActiveVehicle(ctx context.Context, in *DriverVehicle, opts ...grpc.CallOption) (*Vehicle, error)
+	//vehicle_id is the vehicle to drive, the active vehicle if not given
+	GoOnline(ctx context.Context, in *DriverVehicle, opts ...grpc.CallOption) (*Shift, error)
+	GoOffline(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Shift, error)
+	Heartbeat(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error)
+	SetActiveTrip(ctx context.Context, in *ActiveTripRequest, opts ...grpc.CallOption) (*Driver, error)
+	ListDriverShifts(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Shifts, error)
+	GetShiftHours(ctx context.Context, in *ShiftHoursRequest, opts ...grpc.CallOption) (*ShiftHours, error)
+	ListDriverDocuments(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Documents, error)
+	UploadDriverDocument(ctx context.Context, in *DocumentUpload, opts ...grpc.CallOption) (*Document, error)
+	DownloadDriverDocument(ctx context.Context, in *DocumentRequest, opts ...grpc.CallOption) (*DocumentContent, error)
+	ApproveDriver(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error)
+	RejectDriver(ctx context.Context, in *RejectRequest, opts ...grpc.CallOption) (*Result, error)
+	SuspendDriver(ctx context.Context, in *Suspension, opts ...grpc.CallOption) (*Suspension, error)
+	ReinstateDriver(ctx context.Context, in *ReinstateRequest, opts ...grpc.CallOption) (*Result, error)
+	GetDriverSuspension(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Suspension, error)
+	GetDriverAuditLog(ctx context.Context, in *Id, opts ...grpc.CallOption) (*AuditLog, error)
+}
+
+type driverServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDriverServiceClient(cc grpc.ClientConnInterface) DriverServiceClient {
+	return &driverServiceClient{cc}
+}
+
+func (c *driverServiceClient) ListDrivers(ctx context.Context, in *ListDriversRequest, opts ...grpc.CallOption) (*Drivers, error) {
+	out := new(Drivers)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/ListDrivers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDriver(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Driver, error) {
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/GetDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) CreateDriver(ctx context.Context, in *Driver, opts ...grpc.CallOption) (*Driver, error) {
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/CreateDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) UpdateDriver(ctx context.Context, in *Driver, opts ...grpc.CallOption) (*Driver, error) {
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/UpdateDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) DeleteDriver(ctx context.Context, in *VersionedId, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/DeleteDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) RestoreDriver(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/RestoreDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ExportDriver(ctx context.Context, in *Id, opts ...grpc.CallOption) (*DriverExport, error) {
+	out := new(DriverExport)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/ExportDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) AnonymiseDriver(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/AnonymiseDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ListDriverVehicles(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Vehicles, error) {
+	out := new(Vehicles)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/ListDriverVehicles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) AddDriverVehicle(ctx context.Context, in *DriverVehicle, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/AddDriverVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) RemoveDriverVehicle(ctx context.Context, in *DriverVehicle, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/RemoveDriverVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) SetActiveVehicle(ctx context.Context, in *DriverVehicle, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/SetActiveVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GoOnline(ctx context.Context, in *DriverVehicle, opts ...grpc.CallOption) (*Shift, error) {
+	out := new(Shift)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/GoOnline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GoOffline(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Shift, error) {
+	out := new(Shift)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/GoOffline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) Heartbeat(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) SetActiveTrip(ctx context.Context, in *ActiveTripRequest, opts ...grpc.CallOption) (*Driver, error) {
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/SetActiveTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ListDriverShifts(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Shifts, error) {
+	out := new(Shifts)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/ListDriverShifts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetShiftHours(ctx context.Context, in *ShiftHoursRequest, opts ...grpc.CallOption) (*ShiftHours, error) {
+	out := new(ShiftHours)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/GetShiftHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ListDriverDocuments(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Documents, error) {
+	out := new(Documents)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/ListDriverDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) UploadDriverDocument(ctx context.Context, in *DocumentUpload, opts ...grpc.CallOption) (*Document, error) {
+	out := new(Document)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/UploadDriverDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) DownloadDriverDocument(ctx context.Context, in *DocumentRequest, opts ...grpc.CallOption) (*DocumentContent, error) {
+	out := new(DocumentContent)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/DownloadDriverDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ApproveDriver(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/ApproveDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) RejectDriver(ctx context.Context, in *RejectRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/RejectDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) SuspendDriver(ctx context.Context, in *Suspension, opts ...grpc.CallOption) (*Suspension, error) {
+	out := new(Suspension)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/SuspendDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ReinstateDriver(ctx context.Context, in *ReinstateRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/ReinstateDriver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDriverSuspension(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Suspension, error) {
+	out := new(Suspension)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/GetDriverSuspension", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDriverAuditLog(ctx context.Context, in *Id, opts ...grpc.CallOption) (*AuditLog, error) {
+	out := new(AuditLog)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.DriverService/GetDriverAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DriverServiceServer is the server API for DriverService service.
+// All implementations must embed UnimplementedDriverServiceServer
+// for forward compatibility
+type DriverServiceServer interface {
+	ListDrivers(context.Context, *ListDriversRequest) (*Drivers, error)
+	GetDriver(context.Context, *Id) (*Driver, error)
+	CreateDriver(context.Context, *Driver) (*Driver, error)
+	//version has to be the driver's current version, like If-Match
+	UpdateDriver(context.Context, *Driver) (*Driver, error)
+	DeleteDriver(context.Context, *VersionedId) (*Result, error)
+	RestoreDriver(context.Context, *Id) (*Result, error)
+	ExportDriver(context.Context, *Id) (*DriverExport, error)
+	AnonymiseDriver(context.Context, *Id) (*Result, error)
+	ListDriverVehicles(context.Context, *Id) (*Vehicles, error)
+	AddDriverVehicle(context.Context, *DriverVehicle) (*Vehicle, error)
+	RemoveDriverVehicle(context.Context, *DriverVehicle) (*Result, error)
+	SetActiveVehicle(context.Context, *DriverVehicle) (*Vehicle, error)
+	//vehicle_id is the vehicle to drive, the active vehicle if not given
+	GoOnline(context.Context, *DriverVehicle) (*Shift, error)
+	GoOffline(context.Context, *Id) (*Shift, error)
+	Heartbeat(context.Context, *Id) (*Result, error)
+	SetActiveTrip(context.Context, *ActiveTripRequest) (*Driver, error)
+	ListDriverShifts(context.Context, *Id) (*Shifts, error)
+	GetShiftHours(context.Context, *ShiftHoursRequest) (*ShiftHours, error)
+	ListDriverDocuments(context.Context, *Id) (*Documents, error)
+	UploadDriverDocument(context.Context, *DocumentUpload) (*Document, error)
+	DownloadDriverDocument(context.Context, *DocumentRequest) (*DocumentContent, error)
+	ApproveDriver(context.Context, *Id) (*Result, error)
+	RejectDriver(context.Context, *RejectRequest) (*Result, error)
+	SuspendDriver(context.Context, *Suspension) (*Suspension, error)
+	ReinstateDriver(context.Context, *ReinstateRequest) (*Result, error)
+	GetDriverSuspension(context.Context, *Id) (*Suspension, error)
+	GetDriverAuditLog(context.Context, *Id) (*AuditLog, error)
+	mustEmbedUnimplementedDriverServiceServer()
+}
+
+// UnimplementedDriverServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDriverServiceServer struct {
+}
+
+func (UnimplementedDriverServiceServer) ListDrivers(context.Context, *ListDriversRequest) (*Drivers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriver(context.Context, *Id) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) CreateDriver(context.Context, *Driver) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) UpdateDriver(context.Context, *Driver) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) DeleteDriver(context.Context, *VersionedId) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) RestoreDriver(context.Context, *Id) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) ExportDriver(context.Context, *Id) (*DriverExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) AnonymiseDriver(context.Context, *Id) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymiseDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) ListDriverVehicles(context.Context, *Id) (*Vehicles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDriverVehicles not implemented")
+}
+func (UnimplementedDriverServiceServer) AddDriverVehicle(context.Context, *DriverVehicle) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDriverVehicle not implemented")
+}
+func (UnimplementedDriverServiceServer) RemoveDriverVehicle(context.Context, *DriverVehicle) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDriverVehicle not implemented")
+}
+func (UnimplementedDriverServiceServer) SetActiveVehicle(context.Context, *DriverVehicle) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetActiveVehicle not implemented")
+}
+func (UnimplementedDriverServiceServer) GoOnline(context.Context, *DriverVehicle) (*Shift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoOnline not implemented")
+}
+func (UnimplementedDriverServiceServer) GoOffline(context.Context, *Id) (*Shift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoOffline not implemented")
+}
+func (UnimplementedDriverServiceServer) Heartbeat(context.Context, *Id) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedDriverServiceServer) SetActiveTrip(context.Context, *ActiveTripRequest) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetActiveTrip not implemented")
+}
+func (UnimplementedDriverServiceServer) ListDriverShifts(context.Context, *Id) (*Shifts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDriverShifts not implemented")
+}
+func (UnimplementedDriverServiceServer) GetShiftHours(context.Context, *ShiftHoursRequest) (*ShiftHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShiftHours not implemented")
+}
+func (UnimplementedDriverServiceServer) ListDriverDocuments(context.Context, *Id) (*Documents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDriverDocuments not implemented")
+}
+func (UnimplementedDriverServiceServer) UploadDriverDocument(context.Context, *DocumentUpload) (*Document, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadDriverDocument not implemented")
+}
+func (UnimplementedDriverServiceServer) DownloadDriverDocument(context.Context, *DocumentRequest) (*DocumentContent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadDriverDocument not implemented")
+}
+func (UnimplementedDriverServiceServer) ApproveDriver(context.Context, *Id) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) RejectDriver(context.Context, *RejectRequest) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) SuspendDriver(context.Context, *Suspension) (*Suspension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) ReinstateDriver(context.Context, *ReinstateRequest) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinstateDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriverSuspension(context.Context, *Id) (*Suspension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverSuspension not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriverAuditLog(context.Context, *Id) (*AuditLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverAuditLog not implemented")
+}
+func (UnimplementedDriverServiceServer) mustEmbedUnimplementedDriverServiceServer() {}
+
+// UnsafeDriverServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DriverServiceServer will
+// result in compilation errors.
+type UnsafeDriverServiceServer interface {
+	mustEmbedUnimplementedDriverServiceServer()
+}
+
+func RegisterDriverServiceServer(s grpc.ServiceRegistrar, srv DriverServiceServer) {
+	s.RegisterService(&DriverService_ServiceDesc, srv)
+}
+
+func _DriverService_ListDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/ListDrivers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListDrivers(ctx, req.(*ListDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/GetDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriver(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_CreateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Driver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).CreateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/CreateDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).CreateDriver(ctx, req.(*Driver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_UpdateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Driver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).UpdateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/UpdateDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).UpdateDriver(ctx, req.(*Driver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_DeleteDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionedId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).DeleteDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/DeleteDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).DeleteDriver(ctx, req.(*VersionedId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_RestoreDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).RestoreDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/RestoreDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).RestoreDriver(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ExportDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ExportDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/ExportDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ExportDriver(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_AnonymiseDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).AnonymiseDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/AnonymiseDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).AnonymiseDriver(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ListDriverVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListDriverVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/ListDriverVehicles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListDriverVehicles(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_AddDriverVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverVehicle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).AddDriverVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/AddDriverVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).AddDriverVehicle(ctx, req.(*DriverVehicle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_RemoveDriverVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverVehicle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).RemoveDriverVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/RemoveDriverVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).RemoveDriverVehicle(ctx, req.(*DriverVehicle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_SetActiveVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverVehicle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).SetActiveVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/SetActiveVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).SetActiveVehicle(ctx, req.(*DriverVehicle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GoOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverVehicle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GoOnline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/GoOnline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GoOnline(ctx, req.(*DriverVehicle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GoOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GoOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/GoOffline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GoOffline(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).Heartbeat(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_SetActiveTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActiveTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).SetActiveTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/SetActiveTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).SetActiveTrip(ctx, req.(*ActiveTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ListDriverShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListDriverShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/ListDriverShifts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListDriverShifts(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetShiftHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShiftHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetShiftHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/GetShiftHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetShiftHours(ctx, req.(*ShiftHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ListDriverDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListDriverDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/ListDriverDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListDriverDocuments(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_UploadDriverDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).UploadDriverDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/UploadDriverDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).UploadDriverDocument(ctx, req.(*DocumentUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_DownloadDriverDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).DownloadDriverDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/DownloadDriverDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).DownloadDriverDocument(ctx, req.(*DocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ApproveDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ApproveDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/ApproveDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ApproveDriver(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_RejectDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).RejectDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/RejectDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).RejectDriver(ctx, req.(*RejectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_SuspendDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Suspension)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).SuspendDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/SuspendDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).SuspendDriver(ctx, req.(*Suspension))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ReinstateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ReinstateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/ReinstateDriver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ReinstateDriver(ctx, req.(*ReinstateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDriverSuspension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriverSuspension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/GetDriverSuspension",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriverSuspension(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDriverAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriverAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.DriverService/GetDriverAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriverAuditLog(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DriverService_ServiceDesc is the grpc.ServiceDesc for DriverService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DriverService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hytchhyke.driver.DriverService",
+	HandlerType: (*DriverServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDrivers",
+			Handler:    _DriverService_ListDrivers_Handler,
+		},
+		{
+			MethodName: "GetDriver",
+			Handler:    _DriverService_GetDriver_Handler,
+		},
+		{
+			MethodName: "CreateDriver",
+			Handler:    _DriverService_CreateDriver_Handler,
+		},
+		{
+			MethodName: "UpdateDriver",
+			Handler:    _DriverService_UpdateDriver_Handler,
+		},
+		{
+			MethodName: "DeleteDriver",
+			Handler:    _DriverService_DeleteDriver_Handler,
+		},
+		{
+			MethodName: "RestoreDriver",
+			Handler:    _DriverService_RestoreDriver_Handler,
+		},
+		{
+			MethodName: "ExportDriver",
+			Handler:    _DriverService_ExportDriver_Handler,
+		},
+		{
+			MethodName: "AnonymiseDriver",
+			Handler:    _DriverService_AnonymiseDriver_Handler,
+		},
+		{
+			MethodName: "ListDriverVehicles",
+			Handler:    _DriverService_ListDriverVehicles_Handler,
+		},
+		{
+			MethodName: "AddDriverVehicle",
+			Handler:    _DriverService_AddDriverVehicle_Handler,
+		},
+		{
+			MethodName: "RemoveDriverVehicle",
+			Handler:    _DriverService_RemoveDriverVehicle_Handler,
+		},
+		{
+			MethodName: "SetActiveVehicle",
+			Handler:    _DriverService_SetActiveVehicle_Handler,
+		},
+		{
+			MethodName: "GoOnline",
+			Handler:    _DriverService_GoOnline_Handler,
+		},
+		{
+			MethodName: "GoOffline",
+			Handler:    _DriverService_GoOffline_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _DriverService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "SetActiveTrip",
+			Handler:    _DriverService_SetActiveTrip_Handler,
+		},
+		{
+			MethodName: "ListDriverShifts",
+			Handler:    _DriverService_ListDriverShifts_Handler,
+		},
+		{
+			MethodName: "GetShiftHours",
+			Handler:    _DriverService_GetShiftHours_Handler,
+		},
+		{
+			MethodName: "ListDriverDocuments",
+			Handler:    _DriverService_ListDriverDocuments_Handler,
+		},
+		{
+			MethodName: "UploadDriverDocument",
+			Handler:    _DriverService_UploadDriverDocument_Handler,
+		},
+		{
+			MethodName: "DownloadDriverDocument",
+			Handler:    _DriverService_DownloadDriverDocument_Handler,
+		},
+		{
+			MethodName: "ApproveDriver",
+			Handler:    _DriverService_ApproveDriver_Handler,
+		},
+		{
+			MethodName: "RejectDriver",
+			Handler:    _DriverService_RejectDriver_Handler,
+		},
+		{
+			MethodName: "SuspendDriver",
+			Handler:    _DriverService_SuspendDriver_Handler,
+		},
+		{
+			MethodName: "ReinstateDriver",
+			Handler:    _DriverService_ReinstateDriver_Handler,
+		},
+		{
+			MethodName: "GetDriverSuspension",
+			Handler:    _DriverService_GetDriverSuspension_Handler,
+		},
+		{
+			MethodName: "GetDriverAuditLog",
+			Handler:    _DriverService_GetDriverAuditLog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "driver.proto",
+}
+
+// VehicleServiceClient is the client API for VehicleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VehicleServiceClient interface {
+	ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*Vehicles, error)
+	GetVehicle(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Vehicle, error)
+	CreateVehicle(ctx context.Context, in *Vehicle, opts ...grpc.CallOption) (*Vehicle, error)
+	//version has to be the vehicle's current version, like If-Match
+	UpdateVehicle(ctx context.Context, in *Vehicle, opts ...grpc.CallOption) (*Vehicle, error)
+	DeleteVehicle(ctx context.Context, in *VersionedId, opts ...grpc.CallOption) (*Result, error)
+}
+
+type vehicleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVehicleServiceClient(cc grpc.ClientConnInterface) VehicleServiceClient {
+	return &vehicleServiceClient{cc}
+}
+
+func (c *vehicleServiceClient) ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*Vehicles, error) {
+	out := new(Vehicles)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.VehicleService/ListVehicles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) GetVehicle(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.VehicleService/GetVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) CreateVehicle(ctx context.Context, in *Vehicle, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.VehicleService/CreateVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) UpdateVehicle(ctx context.Context, in *Vehicle, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.VehicleService/UpdateVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) DeleteVehicle(ctx context.Context, in *VersionedId, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.driver.VehicleService/DeleteVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VehicleServiceServer is the server API for VehicleService service.
+// All implementations must embed UnimplementedVehicleServiceServer
+// for forward compatibility
+type VehicleServiceServer interface {
+	ListVehicles(context.Context, *ListVehiclesRequest) (*Vehicles, error)
+	GetVehicle(context.Context, *Id) (*Vehicle, error)
+	CreateVehicle(context.Context, *Vehicle) (*Vehicle, error)
+	//version has to be the vehicle's current version, like If-Match
+	UpdateVehicle(context.Context, *Vehicle) (*Vehicle, error)
+	DeleteVehicle(context.Context, *VersionedId) (*Result, error)
+	mustEmbedUnimplementedVehicleServiceServer()
+}
+
+// UnimplementedVehicleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVehicleServiceServer struct {
+}
+
+func (UnimplementedVehicleServiceServer) ListVehicles(context.Context, *ListVehiclesRequest) (*Vehicles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVehicles not implemented")
+}
+func (UnimplementedVehicleServiceServer) GetVehicle(context.Context, *Id) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) CreateVehicle(context.Context, *Vehicle) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) UpdateVehicle(context.Context, *Vehicle) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) DeleteVehicle(context.Context, *VersionedId) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) mustEmbedUnimplementedVehicleServiceServer() {}
+
+// UnsafeVehicleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VehicleServiceServer will
+// result in compilation errors.
+type UnsafeVehicleServiceServer interface {
+	mustEmbedUnimplementedVehicleServiceServer()
+}
+
+func RegisterVehicleServiceServer(s grpc.ServiceRegistrar, srv VehicleServiceServer) {
+	s.RegisterService(&VehicleService_ServiceDesc, srv)
+}
+
+func _VehicleService_ListVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVehiclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).ListVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.VehicleService/ListVehicles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).ListVehicles(ctx, req.(*ListVehiclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_GetVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).GetVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.VehicleService/GetVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).GetVehicle(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_CreateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vehicle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).CreateVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.VehicleService/CreateVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).CreateVehicle(ctx, req.(*Vehicle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_UpdateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vehicle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).UpdateVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.VehicleService/UpdateVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).UpdateVehicle(ctx, req.(*Vehicle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_DeleteVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionedId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).DeleteVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.driver.VehicleService/DeleteVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).DeleteVehicle(ctx, req.(*VersionedId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VehicleService_ServiceDesc is the grpc.ServiceDesc for VehicleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VehicleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hytchhyke.driver.VehicleService",
+	HandlerType: (*VehicleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListVehicles",
+			Handler:    _VehicleService_ListVehicles_Handler,
+		},
+		{
+			MethodName: "GetVehicle",
+			Handler:    _VehicleService_GetVehicle_Handler,
+		},
+		{
+			MethodName: "CreateVehicle",
+			Handler:    _VehicleService_CreateVehicle_Handler,
+		},
+		{
+			MethodName: "UpdateVehicle",
+			Handler:    _VehicleService_UpdateVehicle_Handler,
+		},
+		{
+			MethodName: "DeleteVehicle",
+			Handler:    _VehicleService_DeleteVehicle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "driver.proto",
+}
@@ -0,0 +1,194 @@
+package driverpb
+
+import (
+	"shared/models"
+	"shared/proto/pbtime"
+)
+
+//Conversions between the generated messages and the models package's, so that the same models are used over gRPC and REST
+
+func FromDriver(driver models.Driver) *Driver {
+	return &Driver{
+		Id:               int64(driver.Id),
+		FirstName:        driver.FirstName,
+		LastName:         driver.LastName,
+		MobileNo:         int64(driver.MobileNo),
+		Email:            driver.Email,
+		CarLicenseNo:     driver.CarLicenseNo,
+		Available:        driver.Available,
+		ActiveVehicleId:  int64(driver.ActiveVehicleId),
+		Online:           driver.Online,
+		ActiveTripId:     int64(driver.ActiveTripId),
+		LastActiveAt:     pbtime.RequiredTimestamp(driver.LastActiveAt),
+		OnboardingStatus: driver.OnboardingStatus,
+		OnboardingNote:   driver.OnboardingNote,
+		Version:          int64(driver.Version),
+		DeletedAt:        pbtime.Timestamp(driver.DeletedAt),
+		AnonymisedAt:     pbtime.Timestamp(driver.AnonymisedAt),
+	}
+}
+
+func FromDrivers(drivers []models.Driver) *Drivers {
+	pbDrivers := make([]*Driver, len(drivers))
+	for i, driver := range drivers {
+		pbDrivers[i] = FromDriver(driver)
+	}
+	return &Drivers{Drivers: pbDrivers}
+}
+
+func (driver *Driver) ToModel() models.Driver {
+	return models.Driver{
+		Id:               int(driver.GetId()),
+		FirstName:        driver.GetFirstName(),
+		LastName:         driver.GetLastName(),
+		MobileNo:         int(driver.GetMobileNo()),
+		Email:            driver.GetEmail(),
+		CarLicenseNo:     driver.GetCarLicenseNo(),
+		Available:        driver.GetAvailable(),
+		ActiveVehicleId:  int(driver.GetActiveVehicleId()),
+		Online:           driver.GetOnline(),
+		ActiveTripId:     int(driver.GetActiveTripId()),
+		LastActiveAt:     pbtime.RequiredTime(driver.GetLastActiveAt()),
+		OnboardingStatus: driver.GetOnboardingStatus(),
+		OnboardingNote:   driver.GetOnboardingNote(),
+		Version:          int(driver.GetVersion()),
+		DeletedAt:        pbtime.Time(driver.GetDeletedAt()),
+		AnonymisedAt:     pbtime.Time(driver.GetAnonymisedAt()),
+	}
+}
+
+func (drivers *Drivers) ToModels() []models.Driver {
+	modelDrivers := make([]models.Driver, len(drivers.GetDrivers()))
+	for i, driver := range drivers.GetDrivers() {
+		modelDrivers[i] = driver.ToModel()
+	}
+	return modelDrivers
+}
+
+func FromVehicle(vehicle models.Vehicle) *Vehicle {
+	return &Vehicle{
+		Id:           int64(vehicle.Id),
+		LicensePlate: vehicle.LicensePlate,
+		Make:         vehicle.Make,
+		Model:        vehicle.Model,
+		Colour:       vehicle.Colour,
+		Seats:        int64(vehicle.Seats),
+		VehicleClass: vehicle.VehicleClass,
+		Version:      int64(vehicle.Version),
+	}
+}
+
+func FromVehicles(vehicles []models.Vehicle) *Vehicles {
+	pbVehicles := make([]*Vehicle, len(vehicles))
+	for i, vehicle := range vehicles {
+		pbVehicles[i] = FromVehicle(vehicle)
+	}
+	return &Vehicles{Vehicles: pbVehicles}
+}
+
+func (vehicle *Vehicle) ToModel() models.Vehicle {
+	return models.Vehicle{
+		Id:           int(vehicle.GetId()),
+		LicensePlate: vehicle.GetLicensePlate(),
+		Make:         vehicle.GetMake(),
+		Model:        vehicle.GetModel(),
+		Colour:       vehicle.GetColour(),
+		Seats:        int(vehicle.GetSeats()),
+		VehicleClass: vehicle.GetVehicleClass(),
+		Version:      int(vehicle.GetVersion()),
+	}
+}
+
+func (vehicles *Vehicles) ToModels() []models.Vehicle {
+	modelVehicles := make([]models.Vehicle, len(vehicles.GetVehicles()))
+	for i, vehicle := range vehicles.GetVehicles() {
+		modelVehicles[i] = vehicle.ToModel()
+	}
+	return modelVehicles
+}
+
+func FromShift(shift models.Shift) *Shift {
+	return &Shift{
+		Id:        int64(shift.Id),
+		DriverId:  int64(shift.DriverId),
+		VehicleId: int64(shift.VehicleId),
+		StartedAt: pbtime.RequiredTimestamp(shift.StartedAt),
+		EndedAt:   pbtime.Timestamp(shift.EndedAt),
+		EndReason: shift.EndReason,
+	}
+}
+
+func FromShifts(shifts []models.Shift) *Shifts {
+	pbShifts := make([]*Shift, len(shifts))
+	for i, shift := range shifts {
+		pbShifts[i] = FromShift(shift)
+	}
+	return &Shifts{Shifts: pbShifts}
+}
+
+func (shift *Shift) ToModel() models.Shift {
+	return models.Shift{
+		Id:        int(shift.GetId()),
+		DriverId:  int(shift.GetDriverId()),
+		VehicleId: int(shift.GetVehicleId()),
+		StartedAt: pbtime.RequiredTime(shift.GetStartedAt()),
+		EndedAt:   pbtime.Time(shift.GetEndedAt()),
+		EndReason: shift.GetEndReason(),
+	}
+}
+
+func FromSuspension(suspension models.Suspension) *Suspension {
+	return &Suspension{
+		Id:             int64(suspension.Id),
+		DriverId:       int64(suspension.DriverId),
+		ReasonCode:     suspension.ReasonCode,
+		Note:           suspension.Note,
+		EffectiveFrom:  pbtime.RequiredTimestamp(suspension.EffectiveFrom),
+		EffectiveUntil: pbtime.Timestamp(suspension.EffectiveUntil),
+		Applied:        suspension.Applied,
+		ReinstatedAt:   pbtime.Timestamp(suspension.ReinstatedAt),
+	}
+}
+
+func (suspension *Suspension) ToModel() models.Suspension {
+	return models.Suspension{
+		Id:             int(suspension.GetId()),
+		DriverId:       int(suspension.GetDriverId()),
+		ReasonCode:     suspension.GetReasonCode(),
+		Note:           suspension.GetNote(),
+		EffectiveFrom:  pbtime.RequiredTime(suspension.GetEffectiveFrom()),
+		EffectiveUntil: pbtime.Time(suspension.GetEffectiveUntil()),
+		Applied:        suspension.GetApplied(),
+		ReinstatedAt:   pbtime.Time(suspension.GetReinstatedAt()),
+	}
+}
+
+func FromDocument(document models.Document) *Document {
+	return &Document{
+		Id:           int64(document.Id),
+		DriverId:     int64(document.DriverId),
+		DocumentType: document.DocumentType,
+		ContentType:  document.ContentType,
+		Size:         document.Size,
+		UploadedAt:   pbtime.RequiredTimestamp(document.UploadedAt),
+	}
+}
+
+func FromDocuments(documents []models.Document) *Documents {
+	pbDocuments := make([]*Document, len(documents))
+	for i, document := range documents {
+		pbDocuments[i] = FromDocument(document)
+	}
+	return &Documents{Documents: pbDocuments}
+}
+
+func (document *Document) ToModel() models.Document {
+	return models.Document{
+		Id:           int(document.GetId()),
+		DriverId:     int(document.GetDriverId()),
+		DocumentType: document.GetDocumentType(),
+		ContentType:  document.GetContentType(),
+		Size:         document.GetSize(),
+		UploadedAt:   pbtime.RequiredTime(document.GetUploadedAt()),
+	}
+}
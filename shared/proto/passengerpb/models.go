@@ -0,0 +1,90 @@
+package passengerpb
+
+import (
+	"shared/models"
+	"shared/proto/pbtime"
+)
+
+//Conversions between the generated messages and the models package's, so that the same models are used over gRPC and REST
+
+func FromPassenger(passenger models.Passenger) *Passenger {
+	return &Passenger{
+		Id:           int64(passenger.Id),
+		FirstName:    passenger.FirstName,
+		LastName:     passenger.LastName,
+		MobileNo:     int64(passenger.MobileNo),
+		Email:        passenger.Email,
+		Version:      int64(passenger.Version),
+		DeletedAt:    pbtime.Timestamp(passenger.DeletedAt),
+		AnonymisedAt: pbtime.Timestamp(passenger.AnonymisedAt),
+	}
+}
+
+func FromPassengers(passengers []models.Passenger) *Passengers {
+	pbPassengers := make([]*Passenger, len(passengers))
+	for i, passenger := range passengers {
+		pbPassengers[i] = FromPassenger(passenger)
+	}
+	return &Passengers{Passengers: pbPassengers}
+}
+
+func (passenger *Passenger) ToModel() models.Passenger {
+	return models.Passenger{
+		Id:           int(passenger.GetId()),
+		FirstName:    passenger.GetFirstName(),
+		LastName:     passenger.GetLastName(),
+		MobileNo:     int(passenger.GetMobileNo()),
+		Email:        passenger.GetEmail(),
+		Version:      int(passenger.GetVersion()),
+		DeletedAt:    pbtime.Time(passenger.GetDeletedAt()),
+		AnonymisedAt: pbtime.Time(passenger.GetAnonymisedAt()),
+	}
+}
+
+func (passengers *Passengers) ToModels() []models.Passenger {
+	modelPassengers := make([]models.Passenger, len(passengers.GetPassengers()))
+	for i, passenger := range passengers.GetPassengers() {
+		modelPassengers[i] = passenger.ToModel()
+	}
+	return modelPassengers
+}
+
+func FromPaymentMethod(paymentMethod models.PaymentMethod) *PaymentMethod {
+	return &PaymentMethod{
+		Id:          int64(paymentMethod.Id),
+		PassengerId: int64(paymentMethod.PassengerId),
+		Last4:       paymentMethod.Last4,
+		ExpiryMonth: int64(paymentMethod.ExpiryMonth),
+		ExpiryYear:  int64(paymentMethod.ExpiryYear),
+		Token:       paymentMethod.Token,
+		CardNumber:  paymentMethod.CardNumber,
+	}
+}
+
+func FromPaymentMethods(paymentMethods []models.PaymentMethod) *PaymentMethods {
+	pbPaymentMethods := make([]*PaymentMethod, len(paymentMethods))
+	for i, paymentMethod := range paymentMethods {
+		pbPaymentMethods[i] = FromPaymentMethod(paymentMethod)
+	}
+	return &PaymentMethods{PaymentMethods: pbPaymentMethods}
+}
+
+func (paymentMethod *PaymentMethod) ToModel() models.PaymentMethod {
+	return models.PaymentMethod{
+		Id:          int(paymentMethod.GetId()),
+		PassengerId: int(paymentMethod.GetPassengerId()),
+		Last4:       paymentMethod.GetLast4(),
+		ExpiryMonth: int(paymentMethod.GetExpiryMonth()),
+		ExpiryYear:  int(paymentMethod.GetExpiryYear()),
+		Token:       paymentMethod.GetToken(),
+		CardNumber:  paymentMethod.GetCardNumber(),
+	}
+}
+
+func (paymentMethods *PaymentMethods) ToModels() []models.PaymentMethod {
+	modelPaymentMethods := make([]models.PaymentMethod, len(paymentMethods.GetPaymentMethods()))
+	for i, paymentMethod := range paymentMethods.GetPaymentMethods() {
+		modelPaymentMethods[i] = paymentMethod.ToModel()
+	}
+	return modelPaymentMethods
+}
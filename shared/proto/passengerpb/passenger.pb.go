@@ -0,0 +1,1008 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: passenger.proto
+
+package passengerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	trippb "shared/proto/trippb"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Id struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Id) Reset() {
+	*x = Id{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_passenger_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Id) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Id) ProtoMessage() {}
+
+func (x *Id) ProtoReflect() protoreflect.Message {
+	mi := &file_passenger_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Id.ProtoReflect.Descriptor instead.
+func (*Id) Descriptor() ([]byte, []int) {
+	return file_passenger_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Id) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type VersionedId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *VersionedId) Reset() {
+	*x = VersionedId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_passenger_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionedId) ProtoMessage() {}
+
+func (x *VersionedId) ProtoReflect() protoreflect.Message {
+	mi := &file_passenger_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionedId.ProtoReflect.Descriptor instead.
+func (*VersionedId) Descriptor() ([]byte, []int) {
+	return file_passenger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VersionedId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VersionedId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// What REST responds with as a JSON string
+type Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_passenger_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_passenger_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_passenger_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Result) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Passenger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName    string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName     string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	MobileNo     int64                  `protobuf:"varint,4,opt,name=mobile_no,json=mobileNo,proto3" json:"mobile_no,omitempty"`
+	Email        string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Version      int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	DeletedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	AnonymisedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=anonymised_at,json=anonymisedAt,proto3" json:"anonymised_at,omitempty"`
+}
+
+func (x *Passenger) Reset() {
+	*x = Passenger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_passenger_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Passenger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passenger) ProtoMessage() {}
+
+func (x *Passenger) ProtoReflect() protoreflect.Message {
+	mi := &file_passenger_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passenger.ProtoReflect.Descriptor instead.
+func (*Passenger) Descriptor() ([]byte, []int) {
+	return file_passenger_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Passenger) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Passenger) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Passenger) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Passenger) GetMobileNo() int64 {
+	if x != nil {
+		return x.MobileNo
+	}
+	return 0
+}
+
+func (x *Passenger) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Passenger) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Passenger) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Passenger) GetAnonymisedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnonymisedAt
+	}
+	return nil
+}
+
+type Passengers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Passengers []*Passenger `protobuf:"bytes,1,rep,name=passengers,proto3" json:"passengers,omitempty"`
+}
+
+func (x *Passengers) Reset() {
+	*x = Passengers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_passenger_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Passengers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passengers) ProtoMessage() {}
+
+func (x *Passengers) ProtoReflect() protoreflect.Message {
+	mi := &file_passenger_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passengers.ProtoReflect.Descriptor instead.
+func (*Passengers) Descriptor() ([]byte, []int) {
+	return file_passenger_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Passengers) GetPassengers() []*Passenger {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+// include_deleted needs the admin password
+type ListPassengersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email          string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	IncludeDeleted bool   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+}
+
+func (x *ListPassengersRequest) Reset() {
+	*x = ListPassengersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_passenger_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPassengersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPassengersRequest) ProtoMessage() {}
+
+func (x *ListPassengersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_passenger_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPassengersRequest.ProtoReflect.Descriptor instead.
+func (*ListPassengersRequest) Descriptor() ([]byte, []int) {
+	return file_passenger_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPassengersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ListPassengersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// Only last4 and token are kept once created
+type PaymentMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PassengerId int64  `protobuf:"varint,2,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	Last4       string `protobuf:"bytes,3,opt,name=last4,proto3" json:"last4,omitempty"`
+	ExpiryMonth int64  `protobuf:"varint,4,opt,name=expiry_month,json=expiryMonth,proto3" json:"expiry_month,omitempty"`
+	ExpiryYear  int64  `protobuf:"varint,5,opt,name=expiry_year,json=expiryYear,proto3" json:"expiry_year,omitempty"`
+	Token       string `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	CardNumber  string `protobuf:"bytes,7,opt,name=card_number,json=cardNumber,proto3" json:"card_number,omitempty"` //only sent when creating
+}
+
+func (x *PaymentMethod) Reset() {
+	*x = PaymentMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_passenger_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethod) ProtoMessage() {}
+
+func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_passenger_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
+func (*PaymentMethod) Descriptor() ([]byte, []int) {
+	return file_passenger_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PaymentMethod) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetPassengerId() int64 {
+	if x != nil {
+		return x.PassengerId
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetLast4() string {
+	if x != nil {
+		return x.Last4
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetExpiryMonth() int64 {
+	if x != nil {
+		return x.ExpiryMonth
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetExpiryYear() int64 {
+	if x != nil {
+		return x.ExpiryYear
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetCardNumber() string {
+	if x != nil {
+		return x.CardNumber
+	}
+	return ""
+}
+
+type PaymentMethods struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentMethods []*PaymentMethod `protobuf:"bytes,1,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
+}
+
+func (x *PaymentMethods) Reset() {
+	*x = PaymentMethods{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_passenger_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentMethods) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethods) ProtoMessage() {}
+
+func (x *PaymentMethods) ProtoReflect() protoreflect.Message {
+	mi := &file_passenger_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethods.ProtoReflect.Descriptor instead.
+func (*PaymentMethods) Descriptor() ([]byte, []int) {
+	return file_passenger_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PaymentMethods) GetPaymentMethods() []*PaymentMethod {
+	if x != nil {
+		return x.PaymentMethods
+	}
+	return nil
+}
+
+type PaymentMethodId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassengerId int64 `protobuf:"varint,1,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	Id          int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PaymentMethodId) Reset() {
+	*x = PaymentMethodId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_passenger_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentMethodId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethodId) ProtoMessage() {}
+
+func (x *PaymentMethodId) ProtoReflect() protoreflect.Message {
+	mi := &file_passenger_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethodId.ProtoReflect.Descriptor instead.
+func (*PaymentMethodId) Descriptor() ([]byte, []int) {
+	return file_passenger_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PaymentMethodId) GetPassengerId() int64 {
+	if x != nil {
+		return x.PassengerId
+	}
+	return 0
+}
+
+func (x *PaymentMethodId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Everything held about a passenger, for data subject access requests
+type PassengerExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Passenger      *Passenger             `protobuf:"bytes,1,opt,name=passenger,proto3" json:"passenger,omitempty"`
+	PaymentMethods []*PaymentMethod       `protobuf:"bytes,2,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
+	Trips          []*trippb.Trip         `protobuf:"bytes,3,rep,name=trips,proto3" json:"trips,omitempty"`
+	ExportedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+}
+
+func (x *PassengerExport) Reset() {
+	*x = PassengerExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_passenger_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PassengerExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassengerExport) ProtoMessage() {}
+
+func (x *PassengerExport) ProtoReflect() protoreflect.Message {
+	mi := &file_passenger_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassengerExport.ProtoReflect.Descriptor instead.
+func (*PassengerExport) Descriptor() ([]byte, []int) {
+	return file_passenger_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PassengerExport) GetPassenger() *Passenger {
+	if x != nil {
+		return x.Passenger
+	}
+	return nil
+}
+
+func (x *PassengerExport) GetPaymentMethods() []*PaymentMethod {
+	if x != nil {
+		return x.PaymentMethods
+	}
+	return nil
+}
+
+func (x *PassengerExport) GetTrips() []*trippb.Trip {
+	if x != nil {
+		return x.Trips
+	}
+	return nil
+}
+
+func (x *PassengerExport) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+var File_passenger_proto protoreflect.FileDescriptor
+
+var file_passenger_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x02, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x0b, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x09, 0x50, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a,
+	0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0d, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x6d, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x69, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x4c, 0x0a, 0x0a, 0x50, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x79,
+	0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
+	0x72, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x0a, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x22, 0x56, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22,
+	0xd3, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x73, 0x74, 0x34, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x73, 0x74, 0x34, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x59, 0x65, 0x61, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x73, 0x22, 0x44, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x0f, 0x50,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3c,
+	0x0a, 0x09, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61,
+	0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
+	0x72, 0x52, 0x09, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0f,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b,
+	0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x72, 0x69,
+	0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68,
+	0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x52, 0x05,
+	0x74, 0x72, 0x69, 0x70, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x32, 0xa7, 0x07, 0x0a, 0x10, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x79, 0x74, 0x63,
+	0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b,
+	0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79,
+	0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x1a,
+	0x1e, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12,
+	0x51, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67,
+	0x65, 0x72, 0x12, 0x1e, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67,
+	0x65, 0x72, 0x1a, 0x1e, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67,
+	0x65, 0x72, 0x12, 0x51, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b,
+	0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x1a, 0x1e, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b,
+	0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68,
+	0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x68, 0x79, 0x74,
+	0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x48, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x68, 0x79,
+	0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
+	0x72, 0x2e, 0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65,
+	0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x50, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65,
+	0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x1a, 0x24, 0x2e,
+	0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x4a, 0x0a, 0x12, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x73, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x68, 0x79, 0x74, 0x63,
+	0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e,
+	0x49, 0x64, 0x1a, 0x1b, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x52, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x17, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b,
+	0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x1a, 0x23,
+	0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x73, 0x12, 0x5d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x22, 0x2e, 0x68, 0x79, 0x74,
+	0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x1a, 0x22,
+	0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x58, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x24, 0x2e, 0x68, 0x79, 0x74, 0x63,
+	0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x1a,
+	0x1b, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x1a, 0x5a, 0x18,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_passenger_proto_rawDescOnce sync.Once
+	file_passenger_proto_rawDescData = file_passenger_proto_rawDesc
+)
+
+func file_passenger_proto_rawDescGZIP() []byte {
+	file_passenger_proto_rawDescOnce.Do(func() {
+		file_passenger_proto_rawDescData = protoimpl.X.CompressGZIP(file_passenger_proto_rawDescData)
+	})
+	return file_passenger_proto_rawDescData
+}
+
+var file_passenger_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_passenger_proto_goTypes = []interface{}{
+	(*Id)(nil),                    // 0: hytchhyke.passenger.Id
+	(*VersionedId)(nil),           // 1: hytchhyke.passenger.VersionedId
+	(*Result)(nil),                // 2: hytchhyke.passenger.Result
+	(*Passenger)(nil),             // 3: hytchhyke.passenger.Passenger
+	(*Passengers)(nil),            // 4: hytchhyke.passenger.Passengers
+	(*ListPassengersRequest)(nil), // 5: hytchhyke.passenger.ListPassengersRequest
+	(*PaymentMethod)(nil),         // 6: hytchhyke.passenger.PaymentMethod
+	(*PaymentMethods)(nil),        // 7: hytchhyke.passenger.PaymentMethods
+	(*PaymentMethodId)(nil),       // 8: hytchhyke.passenger.PaymentMethodId
+	(*PassengerExport)(nil),       // 9: hytchhyke.passenger.PassengerExport
+	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
+	(*trippb.Trip)(nil),           // 11: hytchhyke.trip.Trip
+}
+var file_passenger_proto_depIdxs = []int32{
+	10, // 0: hytchhyke.passenger.Passenger.deleted_at:type_name -> google.protobuf.Timestamp
+	10, // 1: hytchhyke.passenger.Passenger.anonymised_at:type_name -> google.protobuf.Timestamp
+	3,  // 2: hytchhyke.passenger.Passengers.passengers:type_name -> hytchhyke.passenger.Passenger
+	6,  // 3: hytchhyke.passenger.PaymentMethods.payment_methods:type_name -> hytchhyke.passenger.PaymentMethod
+	3,  // 4: hytchhyke.passenger.PassengerExport.passenger:type_name -> hytchhyke.passenger.Passenger
+	6,  // 5: hytchhyke.passenger.PassengerExport.payment_methods:type_name -> hytchhyke.passenger.PaymentMethod
+	11, // 6: hytchhyke.passenger.PassengerExport.trips:type_name -> hytchhyke.trip.Trip
+	10, // 7: hytchhyke.passenger.PassengerExport.exported_at:type_name -> google.protobuf.Timestamp
+	5,  // 8: hytchhyke.passenger.PassengerService.ListPassengers:input_type -> hytchhyke.passenger.ListPassengersRequest
+	0,  // 9: hytchhyke.passenger.PassengerService.GetPassenger:input_type -> hytchhyke.passenger.Id
+	3,  // 10: hytchhyke.passenger.PassengerService.CreatePassenger:input_type -> hytchhyke.passenger.Passenger
+	3,  // 11: hytchhyke.passenger.PassengerService.UpdatePassenger:input_type -> hytchhyke.passenger.Passenger
+	1,  // 12: hytchhyke.passenger.PassengerService.DeletePassenger:input_type -> hytchhyke.passenger.VersionedId
+	0,  // 13: hytchhyke.passenger.PassengerService.RestorePassenger:input_type -> hytchhyke.passenger.Id
+	0,  // 14: hytchhyke.passenger.PassengerService.ExportPassenger:input_type -> hytchhyke.passenger.Id
+	0,  // 15: hytchhyke.passenger.PassengerService.AnonymisePassenger:input_type -> hytchhyke.passenger.Id
+	0,  // 16: hytchhyke.passenger.PassengerService.ListPaymentMethods:input_type -> hytchhyke.passenger.Id
+	6,  // 17: hytchhyke.passenger.PassengerService.CreatePaymentMethod:input_type -> hytchhyke.passenger.PaymentMethod
+	8,  // 18: hytchhyke.passenger.PassengerService.DeletePaymentMethod:input_type -> hytchhyke.passenger.PaymentMethodId
+	4,  // 19: hytchhyke.passenger.PassengerService.ListPassengers:output_type -> hytchhyke.passenger.Passengers
+	3,  // 20: hytchhyke.passenger.PassengerService.GetPassenger:output_type -> hytchhyke.passenger.Passenger
+	3,  // 21: hytchhyke.passenger.PassengerService.CreatePassenger:output_type -> hytchhyke.passenger.Passenger
+	3,  // 22: hytchhyke.passenger.PassengerService.UpdatePassenger:output_type -> hytchhyke.passenger.Passenger
+	2,  // 23: hytchhyke.passenger.PassengerService.DeletePassenger:output_type -> hytchhyke.passenger.Result
+	2,  // 24: hytchhyke.passenger.PassengerService.RestorePassenger:output_type -> hytchhyke.passenger.Result
+	9,  // 25: hytchhyke.passenger.PassengerService.ExportPassenger:output_type -> hytchhyke.passenger.PassengerExport
+	2,  // 26: hytchhyke.passenger.PassengerService.AnonymisePassenger:output_type -> hytchhyke.passenger.Result
+	7,  // 27: hytchhyke.passenger.PassengerService.ListPaymentMethods:output_type -> hytchhyke.passenger.PaymentMethods
+	6,  // 28: hytchhyke.passenger.PassengerService.CreatePaymentMethod:output_type -> hytchhyke.passenger.PaymentMethod
+	2,  // 29: hytchhyke.passenger.PassengerService.DeletePaymentMethod:output_type -> hytchhyke.passenger.Result
+	19, // [19:30] is the sub-list for method output_type
+	8,  // [8:19] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_passenger_proto_init() }
+func file_passenger_proto_init() {
+	if File_passenger_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_passenger_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Id); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_passenger_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionedId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_passenger_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_passenger_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Passenger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_passenger_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Passengers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_passenger_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPassengersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_passenger_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentMethod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_passenger_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentMethods); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_passenger_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentMethodId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_passenger_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PassengerExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_passenger_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_passenger_proto_goTypes,
+		DependencyIndexes: file_passenger_proto_depIdxs,
+		MessageInfos:      file_passenger_proto_msgTypes,
+	}.Build()
+	File_passenger_proto = out.File
+	file_passenger_proto_rawDesc = nil
+	file_passenger_proto_goTypes = nil
+	file_passenger_proto_depIdxs = nil
+}
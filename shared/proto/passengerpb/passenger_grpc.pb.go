@@ -0,0 +1,463 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package passengerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PassengerServiceClient is the client API for PassengerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PassengerServiceClient interface {
+	ListPassengers(ctx context.Context, in *ListPassengersRequest, opts ...grpc.CallOption) (*Passengers, error)
+	GetPassenger(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Passenger, error)
+	CreatePassenger(ctx context.Context, in *Passenger, opts ...grpc.CallOption) (*Passenger, error)
+	//version has to be the passenger's current version, like If-Match
+	UpdatePassenger(ctx context.Context, in *Passenger, opts ...grpc.CallOption) (*Passenger, error)
+	DeletePassenger(ctx context.Context, in *VersionedId, opts ...grpc.CallOption) (*Result, error)
+	RestorePassenger(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error)
+	ExportPassenger(ctx context.Context, in *Id, opts ...grpc.CallOption) (*PassengerExport, error)
+	AnonymisePassenger(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error)
+	ListPaymentMethods(ctx context.Context, in *Id, opts ...grpc.CallOption) (*PaymentMethods, error)
+	CreatePaymentMethod(ctx context.Context, in *PaymentMethod, opts ...grpc.CallOption) (*PaymentMethod, error)
+	DeletePaymentMethod(ctx context.Context, in *PaymentMethodId, opts ...grpc.CallOption) (*Result, error)
+}
+
+type passengerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPassengerServiceClient(cc grpc.ClientConnInterface) PassengerServiceClient {
+	return &passengerServiceClient{cc}
+}
+
+func (c *passengerServiceClient) ListPassengers(ctx context.Context, in *ListPassengersRequest, opts ...grpc.CallOption) (*Passengers, error) {
+	out := new(Passengers)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/ListPassengers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passengerServiceClient) GetPassenger(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Passenger, error) {
+	out := new(Passenger)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/GetPassenger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passengerServiceClient) CreatePassenger(ctx context.Context, in *Passenger, opts ...grpc.CallOption) (*Passenger, error) {
+	out := new(Passenger)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/CreatePassenger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passengerServiceClient) UpdatePassenger(ctx context.Context, in *Passenger, opts ...grpc.CallOption) (*Passenger, error) {
+	out := new(Passenger)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/UpdatePassenger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passengerServiceClient) DeletePassenger(ctx context.Context, in *VersionedId, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/DeletePassenger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passengerServiceClient) RestorePassenger(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/RestorePassenger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passengerServiceClient) ExportPassenger(ctx context.Context, in *Id, opts ...grpc.CallOption) (*PassengerExport, error) {
+	out := new(PassengerExport)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/ExportPassenger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passengerServiceClient) AnonymisePassenger(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/AnonymisePassenger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passengerServiceClient) ListPaymentMethods(ctx context.Context, in *Id, opts ...grpc.CallOption) (*PaymentMethods, error) {
+	out := new(PaymentMethods)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/ListPaymentMethods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passengerServiceClient) CreatePaymentMethod(ctx context.Context, in *PaymentMethod, opts ...grpc.CallOption) (*PaymentMethod, error) {
+	out := new(PaymentMethod)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/CreatePaymentMethod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passengerServiceClient) DeletePaymentMethod(ctx context.Context, in *PaymentMethodId, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.passenger.PassengerService/DeletePaymentMethod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PassengerServiceServer is the server API for PassengerService service.
+// All implementations must embed UnimplementedPassengerServiceServer
+// for forward compatibility
+type PassengerServiceServer interface {
+	ListPassengers(context.Context, *ListPassengersRequest) (*Passengers, error)
+	GetPassenger(context.Context, *Id) (*Passenger, error)
+	CreatePassenger(context.Context, *Passenger) (*Passenger, error)
+	//version has to be the passenger's current version, like If-Match
+	UpdatePassenger(context.Context, *Passenger) (*Passenger, error)
+	DeletePassenger(context.Context, *VersionedId) (*Result, error)
+	RestorePassenger(context.Context, *Id) (*Result, error)
+	ExportPassenger(context.Context, *Id) (*PassengerExport, error)
+	AnonymisePassenger(context.Context, *Id) (*Result, error)
+	ListPaymentMethods(context.Context, *Id) (*PaymentMethods, error)
+	CreatePaymentMethod(context.Context, *PaymentMethod) (*PaymentMethod, error)
+	DeletePaymentMethod(context.Context, *PaymentMethodId) (*Result, error)
+	mustEmbedUnimplementedPassengerServiceServer()
+}
+
+// UnimplementedPassengerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPassengerServiceServer struct {
+}
+
+func (UnimplementedPassengerServiceServer) ListPassengers(context.Context, *ListPassengersRequest) (*Passengers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPassengers not implemented")
+}
+func (UnimplementedPassengerServiceServer) GetPassenger(context.Context, *Id) (*Passenger, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPassenger not implemented")
+}
+func (UnimplementedPassengerServiceServer) CreatePassenger(context.Context, *Passenger) (*Passenger, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePassenger not implemented")
+}
+func (UnimplementedPassengerServiceServer) UpdatePassenger(context.Context, *Passenger) (*Passenger, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassenger not implemented")
+}
+func (UnimplementedPassengerServiceServer) DeletePassenger(context.Context, *VersionedId) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePassenger not implemented")
+}
+func (UnimplementedPassengerServiceServer) RestorePassenger(context.Context, *Id) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePassenger not implemented")
+}
+func (UnimplementedPassengerServiceServer) ExportPassenger(context.Context, *Id) (*PassengerExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPassenger not implemented")
+}
+func (UnimplementedPassengerServiceServer) AnonymisePassenger(context.Context, *Id) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnonymisePassenger not implemented")
+}
+func (UnimplementedPassengerServiceServer) ListPaymentMethods(context.Context, *Id) (*PaymentMethods, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentMethods not implemented")
+}
+func (UnimplementedPassengerServiceServer) CreatePaymentMethod(context.Context, *PaymentMethod) (*PaymentMethod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentMethod not implemented")
+}
+func (UnimplementedPassengerServiceServer) DeletePaymentMethod(context.Context, *PaymentMethodId) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePaymentMethod not implemented")
+}
+func (UnimplementedPassengerServiceServer) mustEmbedUnimplementedPassengerServiceServer() {}
+
+// UnsafePassengerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PassengerServiceServer will
+// result in compilation errors.
+type UnsafePassengerServiceServer interface {
+	mustEmbedUnimplementedPassengerServiceServer()
+}
+
+func RegisterPassengerServiceServer(s grpc.ServiceRegistrar, srv PassengerServiceServer) {
+	s.RegisterService(&PassengerService_ServiceDesc, srv)
+}
+
+func _PassengerService_ListPassengers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPassengersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).ListPassengers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/ListPassengers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).ListPassengers(ctx, req.(*ListPassengersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassengerService_GetPassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).GetPassenger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/GetPassenger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).GetPassenger(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassengerService_CreatePassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Passenger)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).CreatePassenger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/CreatePassenger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).CreatePassenger(ctx, req.(*Passenger))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassengerService_UpdatePassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Passenger)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).UpdatePassenger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/UpdatePassenger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).UpdatePassenger(ctx, req.(*Passenger))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassengerService_DeletePassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionedId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).DeletePassenger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/DeletePassenger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).DeletePassenger(ctx, req.(*VersionedId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassengerService_RestorePassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).RestorePassenger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/RestorePassenger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).RestorePassenger(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassengerService_ExportPassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).ExportPassenger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/ExportPassenger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).ExportPassenger(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassengerService_AnonymisePassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).AnonymisePassenger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/AnonymisePassenger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).AnonymisePassenger(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassengerService_ListPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).ListPaymentMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/ListPaymentMethods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).ListPaymentMethods(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassengerService_CreatePaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentMethod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).CreatePaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/CreatePaymentMethod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).CreatePaymentMethod(ctx, req.(*PaymentMethod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PassengerService_DeletePaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentMethodId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassengerServiceServer).DeletePaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.passenger.PassengerService/DeletePaymentMethod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassengerServiceServer).DeletePaymentMethod(ctx, req.(*PaymentMethodId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PassengerService_ServiceDesc is the grpc.ServiceDesc for PassengerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PassengerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hytchhyke.passenger.PassengerService",
+	HandlerType: (*PassengerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPassengers",
+			Handler:    _PassengerService_ListPassengers_Handler,
+		},
+		{
+			MethodName: "GetPassenger",
+			Handler:    _PassengerService_GetPassenger_Handler,
+		},
+		{
+			MethodName: "CreatePassenger",
+			Handler:    _PassengerService_CreatePassenger_Handler,
+		},
+		{
+			MethodName: "UpdatePassenger",
+			Handler:    _PassengerService_UpdatePassenger_Handler,
+		},
+		{
+			MethodName: "DeletePassenger",
+			Handler:    _PassengerService_DeletePassenger_Handler,
+		},
+		{
+			MethodName: "RestorePassenger",
+			Handler:    _PassengerService_RestorePassenger_Handler,
+		},
+		{
+			MethodName: "ExportPassenger",
+			Handler:    _PassengerService_ExportPassenger_Handler,
+		},
+		{
+			MethodName: "AnonymisePassenger",
+			Handler:    _PassengerService_AnonymisePassenger_Handler,
+		},
+		{
+			MethodName: "ListPaymentMethods",
+			Handler:    _PassengerService_ListPaymentMethods_Handler,
+		},
+		{
+			MethodName: "CreatePaymentMethod",
+			Handler:    _PassengerService_CreatePaymentMethod_Handler,
+		},
+		{
+			MethodName: "DeletePaymentMethod",
+			Handler:    _PassengerService_DeletePaymentMethod_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "passenger.proto",
+}
@@ -0,0 +1,88 @@
+package trippb
+
+import (
+	"shared/models"
+	"shared/proto/pbtime"
+)
+
+//Conversions between the generated messages and the models package's, so that the same models are used over gRPC and REST
+
+func FromTrip(trip models.Trip) *Trip {
+	return &Trip{
+		Id:                 int64(trip.Id),
+		PassengerId:        int64(trip.PassengerId),
+		DriverId:           int64(trip.DriverId),
+		VehicleId:          int64(trip.VehicleId),
+		PickUpPostal:       int64(trip.PickUpPostal),
+		DropOffPostal:      int64(trip.DropOffPostal),
+		RideClass:          trip.RideClass,
+		PartySize:          int64(trip.PartySize),
+		Fare:               int64(trip.Fare),
+		Discount:           int64(trip.Discount),
+		PromoCode:          trip.PromoCode,
+		SurgeMultiplier:    trip.SurgeMultiplier,
+		Status:             trip.Status,
+		CreatedAt:          pbtime.RequiredTimestamp(trip.CreatedAt),
+		Version:            int64(trip.Version),
+		DeletedAt:          pbtime.Timestamp(trip.DeletedAt),
+		PaymentMethodToken: trip.PaymentMethodToken,
+	}
+}
+
+func FromTrips(trips []models.Trip) *Trips {
+	pbTrips := make([]*Trip, len(trips))
+	for i, trip := range trips {
+		pbTrips[i] = FromTrip(trip)
+	}
+	return &Trips{Trips: pbTrips}
+}
+
+func (trip *Trip) ToModel() models.Trip {
+	return models.Trip{
+		Id:                 int(trip.GetId()),
+		PassengerId:        int(trip.GetPassengerId()),
+		DriverId:           int(trip.GetDriverId()),
+		VehicleId:          int(trip.GetVehicleId()),
+		PickUpPostal:       int(trip.GetPickUpPostal()),
+		DropOffPostal:      int(trip.GetDropOffPostal()),
+		RideClass:          trip.GetRideClass(),
+		PartySize:          int(trip.GetPartySize()),
+		Fare:               int(trip.GetFare()),
+		Discount:           int(trip.GetDiscount()),
+		PromoCode:          trip.GetPromoCode(),
+		SurgeMultiplier:    trip.GetSurgeMultiplier(),
+		Status:             trip.GetStatus(),
+		CreatedAt:          pbtime.RequiredTime(trip.GetCreatedAt()),
+		Version:            int(trip.GetVersion()),
+		DeletedAt:          pbtime.Time(trip.GetDeletedAt()),
+		PaymentMethodToken: trip.GetPaymentMethodToken(),
+	}
+}
+
+func (trips *Trips) ToModels() []models.Trip {
+	modelTrips := make([]models.Trip, len(trips.GetTrips()))
+	for i, trip := range trips.GetTrips() {
+		modelTrips[i] = trip.ToModel()
+	}
+	return modelTrips
+}
+
+func FromFareQuote(quote models.FareQuote) *FareQuote {
+	return &FareQuote{
+		SurgeMultiplier: quote.SurgeMultiplier,
+		FullFare:        int64(quote.FullFare),
+		Discount:        int64(quote.Discount),
+		Fare:            int64(quote.Fare),
+		PromoCode:       quote.PromoCode,
+	}
+}
+
+func (quote *FareQuote) ToModel() models.FareQuote {
+	return models.FareQuote{
+		SurgeMultiplier: quote.GetSurgeMultiplier(),
+		FullFare:        int(quote.GetFullFare()),
+		Discount:        int(quote.GetDiscount()),
+		Fare:            int(quote.GetFare()),
+		PromoCode:       quote.GetPromoCode(),
+	}
+}
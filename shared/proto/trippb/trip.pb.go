@@ -0,0 +1,1482 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: trip.proto
+
+package trippb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Id struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Id) Reset() {
+	*x = Id{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Id) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Id) ProtoMessage() {}
+
+func (x *Id) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Id.ProtoReflect.Descriptor instead.
+func (*Id) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Id) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type VersionedId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *VersionedId) Reset() {
+	*x = VersionedId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionedId) ProtoMessage() {}
+
+func (x *VersionedId) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionedId.ProtoReflect.Descriptor instead.
+func (*VersionedId) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VersionedId) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VersionedId) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// What REST responds with as a JSON string
+type Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Result) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Trip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PassengerId        int64                  `protobuf:"varint,2,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	DriverId           int64                  `protobuf:"varint,3,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	VehicleId          int64                  `protobuf:"varint,4,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	PickUpPostal       int64                  `protobuf:"varint,5,opt,name=pick_up_postal,json=pickUpPostal,proto3" json:"pick_up_postal,omitempty"`
+	DropOffPostal      int64                  `protobuf:"varint,6,opt,name=drop_off_postal,json=dropOffPostal,proto3" json:"drop_off_postal,omitempty"`
+	RideClass          string                 `protobuf:"bytes,7,opt,name=ride_class,json=rideClass,proto3" json:"ride_class,omitempty"` //"standard", "xl", "premium" or "wheelchair"
+	PartySize          int64                  `protobuf:"varint,8,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	Fare               int64                  `protobuf:"varint,9,opt,name=fare,proto3" json:"fare,omitempty"`          //in cents, after discount
+	Discount           int64                  `protobuf:"varint,10,opt,name=discount,proto3" json:"discount,omitempty"` //in cents
+	PromoCode          string                 `protobuf:"bytes,11,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	SurgeMultiplier    float64                `protobuf:"fixed64,12,opt,name=surge_multiplier,json=surgeMultiplier,proto3" json:"surge_multiplier,omitempty"`
+	Status             string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"` //"waiting", "driving", "finished" or "cancelled"
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Version            int64                  `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
+	DeletedAt          *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PaymentMethodToken string                 `protobuf:"bytes,17,opt,name=payment_method_token,json=paymentMethodToken,proto3" json:"payment_method_token,omitempty"` //only sent when booking
+}
+
+func (x *Trip) Reset() {
+	*x = Trip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trip) ProtoMessage() {}
+
+func (x *Trip) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trip.ProtoReflect.Descriptor instead.
+func (*Trip) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Trip) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Trip) GetPassengerId() int64 {
+	if x != nil {
+		return x.PassengerId
+	}
+	return 0
+}
+
+func (x *Trip) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *Trip) GetVehicleId() int64 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *Trip) GetPickUpPostal() int64 {
+	if x != nil {
+		return x.PickUpPostal
+	}
+	return 0
+}
+
+func (x *Trip) GetDropOffPostal() int64 {
+	if x != nil {
+		return x.DropOffPostal
+	}
+	return 0
+}
+
+func (x *Trip) GetRideClass() string {
+	if x != nil {
+		return x.RideClass
+	}
+	return ""
+}
+
+func (x *Trip) GetPartySize() int64 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+func (x *Trip) GetFare() int64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
+func (x *Trip) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *Trip) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Trip) GetSurgeMultiplier() float64 {
+	if x != nil {
+		return x.SurgeMultiplier
+	}
+	return 0
+}
+
+func (x *Trip) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Trip) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Trip) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Trip) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Trip) GetPaymentMethodToken() string {
+	if x != nil {
+		return x.PaymentMethodToken
+	}
+	return ""
+}
+
+type Trips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trips []*Trip `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
+}
+
+func (x *Trips) Reset() {
+	*x = Trips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trips) ProtoMessage() {}
+
+func (x *Trips) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trips.ProtoReflect.Descriptor instead.
+func (*Trips) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Trips) GetTrips() []*Trip {
+	if x != nil {
+		return x.Trips
+	}
+	return nil
+}
+
+// Trips of a passenger or driver, include_deleted needs the admin password
+type ListTripsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassengerId    int64 `protobuf:"varint,1,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	DriverId       int64 `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	IncludeDeleted bool  `protobuf:"varint,3,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+}
+
+func (x *ListTripsRequest) Reset() {
+	*x = ListTripsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTripsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripsRequest) ProtoMessage() {}
+
+func (x *ListTripsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripsRequest.ProtoReflect.Descriptor instead.
+func (*ListTripsRequest) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTripsRequest) GetPassengerId() int64 {
+	if x != nil {
+		return x.PassengerId
+	}
+	return 0
+}
+
+func (x *ListTripsRequest) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *ListTripsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TripId      int64  `protobuf:"varint,2,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	PassengerId int64  `protobuf:"varint,3,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	Amount      int64  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"` //in cents
+	Reference   string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Status      string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` //"authorised", "captured" or "refunded"
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Payment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Payment) GetTripId() int64 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *Payment) GetPassengerId() int64 {
+	if x != nil {
+		return x.PassengerId
+	}
+	return 0
+}
+
+func (x *Payment) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payment) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type FareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassengerId   int64  `protobuf:"varint,1,opt,name=passenger_id,json=passengerId,proto3" json:"passenger_id,omitempty"`
+	PickUpPostal  int64  `protobuf:"varint,2,opt,name=pick_up_postal,json=pickUpPostal,proto3" json:"pick_up_postal,omitempty"`
+	DropOffPostal int64  `protobuf:"varint,3,opt,name=drop_off_postal,json=dropOffPostal,proto3" json:"drop_off_postal,omitempty"`
+	RideClass     string `protobuf:"bytes,4,opt,name=ride_class,json=rideClass,proto3" json:"ride_class,omitempty"` //"standard" if not given
+	PromoCode     string `protobuf:"bytes,5,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+}
+
+func (x *FareRequest) Reset() {
+	*x = FareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FareRequest) ProtoMessage() {}
+
+func (x *FareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FareRequest.ProtoReflect.Descriptor instead.
+func (*FareRequest) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FareRequest) GetPassengerId() int64 {
+	if x != nil {
+		return x.PassengerId
+	}
+	return 0
+}
+
+func (x *FareRequest) GetPickUpPostal() int64 {
+	if x != nil {
+		return x.PickUpPostal
+	}
+	return 0
+}
+
+func (x *FareRequest) GetDropOffPostal() int64 {
+	if x != nil {
+		return x.DropOffPostal
+	}
+	return 0
+}
+
+func (x *FareRequest) GetRideClass() string {
+	if x != nil {
+		return x.RideClass
+	}
+	return ""
+}
+
+func (x *FareRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+type FareQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SurgeMultiplier float64 `protobuf:"fixed64,1,opt,name=surge_multiplier,json=surgeMultiplier,proto3" json:"surge_multiplier,omitempty"`
+	FullFare        int64   `protobuf:"varint,2,opt,name=full_fare,json=fullFare,proto3" json:"full_fare,omitempty"`
+	Discount        int64   `protobuf:"varint,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	Fare            int64   `protobuf:"varint,4,opt,name=fare,proto3" json:"fare,omitempty"`
+	PromoCode       string  `protobuf:"bytes,5,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+}
+
+func (x *FareQuote) Reset() {
+	*x = FareQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FareQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FareQuote) ProtoMessage() {}
+
+func (x *FareQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FareQuote.ProtoReflect.Descriptor instead.
+func (*FareQuote) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FareQuote) GetSurgeMultiplier() float64 {
+	if x != nil {
+		return x.SurgeMultiplier
+	}
+	return 0
+}
+
+func (x *FareQuote) GetFullFare() int64 {
+	if x != nil {
+		return x.FullFare
+	}
+	return 0
+}
+
+func (x *FareQuote) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *FareQuote) GetFare() int64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
+func (x *FareQuote) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+type SurgeRegion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WaitingTrips     int64   `protobuf:"varint,1,opt,name=waiting_trips,json=waitingTrips,proto3" json:"waiting_trips,omitempty"`
+	AvailableDrivers float64 `protobuf:"fixed64,2,opt,name=available_drivers,json=availableDrivers,proto3" json:"available_drivers,omitempty"`
+	Multiplier       float64 `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *SurgeRegion) Reset() {
+	*x = SurgeRegion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SurgeRegion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurgeRegion) ProtoMessage() {}
+
+func (x *SurgeRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurgeRegion.ProtoReflect.Descriptor instead.
+func (*SurgeRegion) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SurgeRegion) GetWaitingTrips() int64 {
+	if x != nil {
+		return x.WaitingTrips
+	}
+	return 0
+}
+
+func (x *SurgeRegion) GetAvailableDrivers() float64 {
+	if x != nil {
+		return x.AvailableDrivers
+	}
+	return 0
+}
+
+func (x *SurgeRegion) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+// Keyed by postal sector
+type Surge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Regions map[int64]*SurgeRegion `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Surge) Reset() {
+	*x = Surge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Surge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Surge) ProtoMessage() {}
+
+func (x *Surge) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Surge.ProtoReflect.Descriptor instead.
+func (*Surge) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Surge) GetRegions() map[int64]*SurgeRegion {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+type PromoCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code                string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	DiscountType        string                 `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`     //"percentage" or "flat"
+	DiscountValue       int64                  `protobuf:"varint,4,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"` //percent off, or cents off
+	MinFare             int64                  `protobuf:"varint,5,opt,name=min_fare,json=minFare,proto3" json:"min_fare,omitempty"`                   //in cents, 0 for no minimum
+	ExpiresAt           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxUses             int64                  `protobuf:"varint,7,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`                                         //across all passengers, 0 for unlimited
+	MaxUsesPerPassenger int64                  `protobuf:"varint,8,opt,name=max_uses_per_passenger,json=maxUsesPerPassenger,proto3" json:"max_uses_per_passenger,omitempty"` //0 for unlimited
+	Uses                int64                  `protobuf:"varint,9,opt,name=uses,proto3" json:"uses,omitempty"`
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PromoCode) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromoCode) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *PromoCode) GetDiscountValue() int64 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMinFare() int64 {
+	if x != nil {
+		return x.MinFare
+	}
+	return 0
+}
+
+func (x *PromoCode) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetMaxUses() int64 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMaxUsesPerPassenger() int64 {
+	if x != nil {
+		return x.MaxUsesPerPassenger
+	}
+	return 0
+}
+
+func (x *PromoCode) GetUses() int64 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+type PromoCodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromoCodes []*PromoCode `protobuf:"bytes,1,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+}
+
+func (x *PromoCodes) Reset() {
+	*x = PromoCodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoCodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCodes) ProtoMessage() {}
+
+func (x *PromoCodes) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCodes.ProtoReflect.Descriptor instead.
+func (*PromoCodes) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PromoCodes) GetPromoCodes() []*PromoCode {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+type PromoCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *PromoCodeRequest) Reset() {
+	*x = PromoCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_trip_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCodeRequest) ProtoMessage() {}
+
+func (x *PromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_trip_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*PromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_trip_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+var File_trip_proto protoreflect.FileDescriptor
+
+var file_trip_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x68, 0x79,
+	0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x02, 0x49, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x37, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x49, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x0a, 0x06, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd5, 0x04,
+	0x0a, 0x04, 0x54, 0x72, 0x69, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x61,
+	0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70,
+	0x69, 0x63, 0x6b, 0x55, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x64,
+	0x72, 0x6f, 0x70, 0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x50, 0x6f, 0x73,
+	0x74, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x69, 0x64, 0x65, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x61, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x66, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x75, 0x72, 0x67,
+	0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x33, 0x0a, 0x05, 0x54, 0x72, 0x69, 0x70, 0x73, 0x12, 0x2a,
+	0x0a, 0x05, 0x74, 0x72, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54,
+	0x72, 0x69, 0x70, 0x52, 0x05, 0x74, 0x72, 0x69, 0x70, 0x73, 0x22, 0x7b, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xbc, 0x01,
+	0x0a, 0x0b, 0x46, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x69, 0x63, 0x6b, 0x55, 0x70,
+	0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x6f,
+	0x66, 0x66, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x64, 0x72, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x69, 0x64, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xa2, 0x01, 0x0a,
+	0x09, 0x46, 0x61, 0x72, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75,
+	0x72, 0x67, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x75, 0x72, 0x67, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x66, 0x61,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x46, 0x61,
+	0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x61,
+	0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x7f, 0x0a, 0x0b, 0x53, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x69, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x54, 0x72, 0x69, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x22, 0x9e, 0x01, 0x0a, 0x05, 0x53, 0x75, 0x72, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x07,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x53,
+	0x75, 0x72, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x57, 0x0a, 0x0c, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68, 0x79,
+	0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x53, 0x75, 0x72,
+	0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xb5, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x46, 0x61, 0x72, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73,
+	0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x73, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x50, 0x61,
+	0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x0a, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x32, 0x93, 0x06,
+	0x0a, 0x0b, 0x54, 0x72, 0x69, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a,
+	0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x70, 0x73, 0x12, 0x20, 0x2e, 0x68, 0x79, 0x74,
+	0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x72, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x68,
+	0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54, 0x72,
+	0x69, 0x70, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x12, 0x12,
+	0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e,
+	0x49, 0x64, 0x1a, 0x14, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74,
+	0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x12, 0x38, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x12, 0x14, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79,
+	0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x1a, 0x14, 0x2e, 0x68,
+	0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54, 0x72,
+	0x69, 0x70, 0x12, 0x38, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70,
+	0x12, 0x14, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69,
+	0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x1a, 0x14, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79,
+	0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x12, 0x41, 0x0a, 0x0a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x69, 0x70, 0x12, 0x1b, 0x2e, 0x68, 0x79, 0x74,
+	0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x65, 0x64, 0x49, 0x64, 0x1a, 0x16, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68,
+	0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x39, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x72, 0x69, 0x70, 0x12, 0x12,
+	0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e,
+	0x49, 0x64, 0x1a, 0x16, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74,
+	0x72, 0x69, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x69, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x68,
+	0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x49, 0x64,
+	0x1a, 0x17, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69,
+	0x70, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x46, 0x61, 0x72, 0x65, 0x12, 0x1b, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65,
+	0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72,
+	0x69, 0x70, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x72, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x15, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69,
+	0x70, 0x2e, 0x53, 0x75, 0x72, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x1a, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72,
+	0x69, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x47, 0x0a,
+	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x19, 0x2e, 0x68, 0x79, 0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69,
+	0x70, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x19, 0x2e, 0x68, 0x79,
+	0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x2e, 0x68, 0x79, 0x74, 0x63,
+	0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x68, 0x79,
+	0x74, 0x63, 0x68, 0x68, 0x79, 0x6b, 0x65, 0x2e, 0x74, 0x72, 0x69, 0x70, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x42, 0x15, 0x5a, 0x13, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x69, 0x70, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_trip_proto_rawDescOnce sync.Once
+	file_trip_proto_rawDescData = file_trip_proto_rawDesc
+)
+
+func file_trip_proto_rawDescGZIP() []byte {
+	file_trip_proto_rawDescOnce.Do(func() {
+		file_trip_proto_rawDescData = protoimpl.X.CompressGZIP(file_trip_proto_rawDescData)
+	})
+	return file_trip_proto_rawDescData
+}
+
+var file_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_trip_proto_goTypes = []interface{}{
+	(*Id)(nil),                    // 0: hytchhyke.trip.Id
+	(*VersionedId)(nil),           // 1: hytchhyke.trip.VersionedId
+	(*Result)(nil),                // 2: hytchhyke.trip.Result
+	(*Trip)(nil),                  // 3: hytchhyke.trip.Trip
+	(*Trips)(nil),                 // 4: hytchhyke.trip.Trips
+	(*ListTripsRequest)(nil),      // 5: hytchhyke.trip.ListTripsRequest
+	(*Payment)(nil),               // 6: hytchhyke.trip.Payment
+	(*FareRequest)(nil),           // 7: hytchhyke.trip.FareRequest
+	(*FareQuote)(nil),             // 8: hytchhyke.trip.FareQuote
+	(*SurgeRegion)(nil),           // 9: hytchhyke.trip.SurgeRegion
+	(*Surge)(nil),                 // 10: hytchhyke.trip.Surge
+	(*PromoCode)(nil),             // 11: hytchhyke.trip.PromoCode
+	(*PromoCodes)(nil),            // 12: hytchhyke.trip.PromoCodes
+	(*PromoCodeRequest)(nil),      // 13: hytchhyke.trip.PromoCodeRequest
+	nil,                           // 14: hytchhyke.trip.Surge.RegionsEntry
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 16: google.protobuf.Empty
+}
+var file_trip_proto_depIdxs = []int32{
+	15, // 0: hytchhyke.trip.Trip.created_at:type_name -> google.protobuf.Timestamp
+	15, // 1: hytchhyke.trip.Trip.deleted_at:type_name -> google.protobuf.Timestamp
+	3,  // 2: hytchhyke.trip.Trips.trips:type_name -> hytchhyke.trip.Trip
+	14, // 3: hytchhyke.trip.Surge.regions:type_name -> hytchhyke.trip.Surge.RegionsEntry
+	15, // 4: hytchhyke.trip.PromoCode.expires_at:type_name -> google.protobuf.Timestamp
+	11, // 5: hytchhyke.trip.PromoCodes.promo_codes:type_name -> hytchhyke.trip.PromoCode
+	9,  // 6: hytchhyke.trip.Surge.RegionsEntry.value:type_name -> hytchhyke.trip.SurgeRegion
+	5,  // 7: hytchhyke.trip.TripService.ListTrips:input_type -> hytchhyke.trip.ListTripsRequest
+	0,  // 8: hytchhyke.trip.TripService.GetTrip:input_type -> hytchhyke.trip.Id
+	3,  // 9: hytchhyke.trip.TripService.CreateTrip:input_type -> hytchhyke.trip.Trip
+	3,  // 10: hytchhyke.trip.TripService.UpdateTrip:input_type -> hytchhyke.trip.Trip
+	1,  // 11: hytchhyke.trip.TripService.DeleteTrip:input_type -> hytchhyke.trip.VersionedId
+	0,  // 12: hytchhyke.trip.TripService.RestoreTrip:input_type -> hytchhyke.trip.Id
+	0,  // 13: hytchhyke.trip.TripService.GetTripPayment:input_type -> hytchhyke.trip.Id
+	7,  // 14: hytchhyke.trip.TripService.GetFare:input_type -> hytchhyke.trip.FareRequest
+	16, // 15: hytchhyke.trip.TripService.GetSurge:input_type -> google.protobuf.Empty
+	16, // 16: hytchhyke.trip.TripService.ListPromoCodes:input_type -> google.protobuf.Empty
+	11, // 17: hytchhyke.trip.TripService.CreatePromoCode:input_type -> hytchhyke.trip.PromoCode
+	13, // 18: hytchhyke.trip.TripService.DeletePromoCode:input_type -> hytchhyke.trip.PromoCodeRequest
+	4,  // 19: hytchhyke.trip.TripService.ListTrips:output_type -> hytchhyke.trip.Trips
+	3,  // 20: hytchhyke.trip.TripService.GetTrip:output_type -> hytchhyke.trip.Trip
+	3,  // 21: hytchhyke.trip.TripService.CreateTrip:output_type -> hytchhyke.trip.Trip
+	3,  // 22: hytchhyke.trip.TripService.UpdateTrip:output_type -> hytchhyke.trip.Trip
+	2,  // 23: hytchhyke.trip.TripService.DeleteTrip:output_type -> hytchhyke.trip.Result
+	2,  // 24: hytchhyke.trip.TripService.RestoreTrip:output_type -> hytchhyke.trip.Result
+	6,  // 25: hytchhyke.trip.TripService.GetTripPayment:output_type -> hytchhyke.trip.Payment
+	8,  // 26: hytchhyke.trip.TripService.GetFare:output_type -> hytchhyke.trip.FareQuote
+	10, // 27: hytchhyke.trip.TripService.GetSurge:output_type -> hytchhyke.trip.Surge
+	12, // 28: hytchhyke.trip.TripService.ListPromoCodes:output_type -> hytchhyke.trip.PromoCodes
+	11, // 29: hytchhyke.trip.TripService.CreatePromoCode:output_type -> hytchhyke.trip.PromoCode
+	2,  // 30: hytchhyke.trip.TripService.DeletePromoCode:output_type -> hytchhyke.trip.Result
+	19, // [19:31] is the sub-list for method output_type
+	7,  // [7:19] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_trip_proto_init() }
+func file_trip_proto_init() {
+	if File_trip_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_trip_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Id); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionedId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTripsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FareQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SurgeRegion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Surge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoCodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_trip_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_trip_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_trip_proto_goTypes,
+		DependencyIndexes: file_trip_proto_depIdxs,
+		MessageInfos:      file_trip_proto_msgTypes,
+	}.Build()
+	File_trip_proto = out.File
+	file_trip_proto_rawDesc = nil
+	file_trip_proto_goTypes = nil
+	file_trip_proto_depIdxs = nil
+}
@@ -0,0 +1,500 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package trippb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TripServiceClient is the client API for TripService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TripServiceClient interface {
+	ListTrips(ctx context.Context, in *ListTripsRequest, opts ...grpc.CallOption) (*Trips, error)
+	GetTrip(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Trip, error)
+	CreateTrip(ctx context.Context, in *Trip, opts ...grpc.CallOption) (*Trip, error)
+	//version has to be the trip's current version, like If-Match
+	UpdateTrip(ctx context.Context, in *Trip, opts ...grpc.CallOption) (*Trip, error)
+	DeleteTrip(ctx context.Context, in *VersionedId, opts ...grpc.CallOption) (*Result, error)
+	RestoreTrip(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error)
+	GetTripPayment(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Payment, error)
+	GetFare(ctx context.Context, in *FareRequest, opts ...grpc.CallOption) (*FareQuote, error)
+	GetSurge(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Surge, error)
+	ListPromoCodes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PromoCodes, error)
+	CreatePromoCode(ctx context.Context, in *PromoCode, opts ...grpc.CallOption) (*PromoCode, error)
+	DeletePromoCode(ctx context.Context, in *PromoCodeRequest, opts ...grpc.CallOption) (*Result, error)
+}
+
+type tripServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTripServiceClient(cc grpc.ClientConnInterface) TripServiceClient {
+	return &tripServiceClient{cc}
+}
+
+func (c *tripServiceClient) ListTrips(ctx context.Context, in *ListTripsRequest, opts ...grpc.CallOption) (*Trips, error) {
+	out := new(Trips)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/ListTrips", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetTrip(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Trip, error) {
+	out := new(Trip)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/GetTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) CreateTrip(ctx context.Context, in *Trip, opts ...grpc.CallOption) (*Trip, error) {
+	out := new(Trip)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/CreateTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) UpdateTrip(ctx context.Context, in *Trip, opts ...grpc.CallOption) (*Trip, error) {
+	out := new(Trip)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/UpdateTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) DeleteTrip(ctx context.Context, in *VersionedId, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/DeleteTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) RestoreTrip(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/RestoreTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetTripPayment(ctx context.Context, in *Id, opts ...grpc.CallOption) (*Payment, error) {
+	out := new(Payment)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/GetTripPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetFare(ctx context.Context, in *FareRequest, opts ...grpc.CallOption) (*FareQuote, error) {
+	out := new(FareQuote)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/GetFare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetSurge(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Surge, error) {
+	out := new(Surge)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/GetSurge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) ListPromoCodes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PromoCodes, error) {
+	out := new(PromoCodes)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/ListPromoCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) CreatePromoCode(ctx context.Context, in *PromoCode, opts ...grpc.CallOption) (*PromoCode, error) {
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/CreatePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) DeletePromoCode(ctx context.Context, in *PromoCodeRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/hytchhyke.trip.TripService/DeletePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TripServiceServer is the server API for TripService service.
+// All implementations must embed UnimplementedTripServiceServer
+// for forward compatibility
+type TripServiceServer interface {
+	ListTrips(context.Context, *ListTripsRequest) (*Trips, error)
+	GetTrip(context.Context, *Id) (*Trip, error)
+	CreateTrip(context.Context, *Trip) (*Trip, error)
+	//version has to be the trip's current version, like If-Match
+	UpdateTrip(context.Context, *Trip) (*Trip, error)
+	DeleteTrip(context.Context, *VersionedId) (*Result, error)
+	RestoreTrip(context.Context, *Id) (*Result, error)
+	GetTripPayment(context.Context, *Id) (*Payment, error)
+	GetFare(context.Context, *FareRequest) (*FareQuote, error)
+	GetSurge(context.Context, *emptypb.Empty) (*Surge, error)
+	ListPromoCodes(context.Context, *emptypb.Empty) (*PromoCodes, error)
+	CreatePromoCode(context.Context, *PromoCode) (*PromoCode, error)
+	DeletePromoCode(context.Context, *PromoCodeRequest) (*Result, error)
+	mustEmbedUnimplementedTripServiceServer()
+}
+
+// UnimplementedTripServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTripServiceServer struct {
+}
+
+func (UnimplementedTripServiceServer) ListTrips(context.Context, *ListTripsRequest) (*Trips, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrips not implemented")
+}
+func (UnimplementedTripServiceServer) GetTrip(context.Context, *Id) (*Trip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrip not implemented")
+}
+func (UnimplementedTripServiceServer) CreateTrip(context.Context, *Trip) (*Trip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrip not implemented")
+}
+func (UnimplementedTripServiceServer) UpdateTrip(context.Context, *Trip) (*Trip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrip not implemented")
+}
+func (UnimplementedTripServiceServer) DeleteTrip(context.Context, *VersionedId) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrip not implemented")
+}
+func (UnimplementedTripServiceServer) RestoreTrip(context.Context, *Id) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTrip not implemented")
+}
+func (UnimplementedTripServiceServer) GetTripPayment(context.Context, *Id) (*Payment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripPayment not implemented")
+}
+func (UnimplementedTripServiceServer) GetFare(context.Context, *FareRequest) (*FareQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFare not implemented")
+}
+func (UnimplementedTripServiceServer) GetSurge(context.Context, *emptypb.Empty) (*Surge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSurge not implemented")
+}
+func (UnimplementedTripServiceServer) ListPromoCodes(context.Context, *emptypb.Empty) (*PromoCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromoCodes not implemented")
+}
+func (UnimplementedTripServiceServer) CreatePromoCode(context.Context, *PromoCode) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedTripServiceServer) DeletePromoCode(context.Context, *PromoCodeRequest) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePromoCode not implemented")
+}
+func (UnimplementedTripServiceServer) mustEmbedUnimplementedTripServiceServer() {}
+
+// UnsafeTripServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TripServiceServer will
+// result in compilation errors.
+type UnsafeTripServiceServer interface {
+	mustEmbedUnimplementedTripServiceServer()
+}
+
+func RegisterTripServiceServer(s grpc.ServiceRegistrar, srv TripServiceServer) {
+	s.RegisterService(&TripService_ServiceDesc, srv)
+}
+
+func _TripService_ListTrips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTripsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).ListTrips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/ListTrips",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).ListTrips(ctx, req.(*ListTripsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/GetTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetTrip(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_CreateTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Trip)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).CreateTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/CreateTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).CreateTrip(ctx, req.(*Trip))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_UpdateTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Trip)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).UpdateTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/UpdateTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).UpdateTrip(ctx, req.(*Trip))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_DeleteTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionedId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).DeleteTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/DeleteTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).DeleteTrip(ctx, req.(*VersionedId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_RestoreTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).RestoreTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/RestoreTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).RestoreTrip(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetTripPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetTripPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/GetTripPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetTripPayment(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetFare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetFare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/GetFare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetFare(ctx, req.(*FareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetSurge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetSurge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/GetSurge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetSurge(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_ListPromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).ListPromoCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/ListPromoCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).ListPromoCodes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/CreatePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).CreatePromoCode(ctx, req.(*PromoCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_DeletePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).DeletePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hytchhyke.trip.TripService/DeletePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).DeletePromoCode(ctx, req.(*PromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TripService_ServiceDesc is the grpc.ServiceDesc for TripService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TripService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hytchhyke.trip.TripService",
+	HandlerType: (*TripServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTrips",
+			Handler:    _TripService_ListTrips_Handler,
+		},
+		{
+			MethodName: "GetTrip",
+			Handler:    _TripService_GetTrip_Handler,
+		},
+		{
+			MethodName: "CreateTrip",
+			Handler:    _TripService_CreateTrip_Handler,
+		},
+		{
+			MethodName: "UpdateTrip",
+			Handler:    _TripService_UpdateTrip_Handler,
+		},
+		{
+			MethodName: "DeleteTrip",
+			Handler:    _TripService_DeleteTrip_Handler,
+		},
+		{
+			MethodName: "RestoreTrip",
+			Handler:    _TripService_RestoreTrip_Handler,
+		},
+		{
+			MethodName: "GetTripPayment",
+			Handler:    _TripService_GetTripPayment_Handler,
+		},
+		{
+			MethodName: "GetFare",
+			Handler:    _TripService_GetFare_Handler,
+		},
+		{
+			MethodName: "GetSurge",
+			Handler:    _TripService_GetSurge_Handler,
+		},
+		{
+			MethodName: "ListPromoCodes",
+			Handler:    _TripService_ListPromoCodes_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _TripService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "DeletePromoCode",
+			Handler:    _TripService_DeletePromoCode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "trip.proto",
+}
@@ -0,0 +1,218 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"shared/logging"
+	"shared/service"
+)
+
+//Metadata keys, the gRPC versions of the X-Request-ID header and adminPassword query parameter
+const RequestIdKey = "x-request-id"
+const AdminPasswordKey = "admin-password"
+
+//Key of the HTTP status a service.Error had, sent in the error details since several share a gRPC code
+const httpStatusKey = "http-status"
+
+//Calls to other microservices are given up on after this unless their context ends sooner
+const callTimeout = 5 * time.Second
+
+//Driver documents are uploaded in one message, so this is more than their 10MB limit
+const maxMessageSize = 16 << 20
+
+/*
+This function returns a gRPC server that traces and logs every call under its x-request-id,
+lets service functions know whether it had the admin password, and turns service.Errors into gRPC statuses
+*/
+func NewServer(adminPassword string, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append([]grpc.ServerOption{
+		grpc.MaxRecvMsgSize(maxMessageSize),
+		grpc.MaxSendMsgSize(maxMessageSize),
+		grpc.ChainUnaryInterceptor(
+			otelgrpc.UnaryServerInterceptor(),
+			requestIdInterceptor,
+			adminInterceptor(adminPassword),
+			errorInterceptor,
+		),
+	}, opts...)
+
+	server := grpc.NewServer(opts...)
+	reflection.Register(server)
+	return server
+}
+
+/*
+This function connects to another microservice's gRPC server at address, e.g. localhost:6002.
+Calls are traced as part of their context, sent with its request ID and time out after 5 seconds
+*/
+func Dial(address string) (*grpc.ClientConn, error) {
+	return grpc.Dial(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize)),
+		grpc.WithChainUnaryInterceptor(
+			otelgrpc.UnaryClientInterceptor(),
+			clientInterceptor,
+		),
+	)
+}
+
+//Returns ctx with adminPassword sent on the calls made with it, for calls that are admin only
+func WithAdminPassword(ctx context.Context, adminPassword string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, AdminPasswordKey, adminPassword)
+}
+
+/////////////////////////
+//                     //
+//     Interceptors    //
+//                     //
+/////////////////////////
+
+//Logs the call like logging.Middleware logs requests, under the x-request-id it came with or a new one
+func requestIdInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	id := logging.ValidRequestId(firstMetadata(ctx, RequestIdKey))
+	ctx = logging.WithRequestId(ctx, id)
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIdKey, id))
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	code := status.Code(err)
+	fields := map[string]interface{}{
+		"requestId":  id,
+		"method":     info.FullMethod,
+		"code":       code.String(),
+		"durationMs": float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if code == codes.Internal || code == codes.Unknown {
+		logging.Error("request", fields)
+	} else {
+		logging.Info("request", fields)
+	}
+	return resp, err
+}
+
+func adminInterceptor(adminPassword string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		given := firstMetadata(ctx, AdminPasswordKey)
+		return handler(service.WithAdmin(ctx, given != "" && given == adminPassword), req)
+	}
+}
+
+//Service functions return service.Errors with HTTP statuses, which are sent as the matching gRPC code
+func errorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return resp, err
+	}
+
+	serviceErr, ok := err.(*service.Error)
+	if !ok {
+		return resp, status.Error(codes.Internal, err.Error())
+	}
+	st := status.New(statusCode(serviceErr.Status), serviceErr.Message)
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   http.StatusText(serviceErr.Status),
+		Metadata: map[string]string{httpStatusKey: strconv.Itoa(serviceErr.Status)},
+	})
+	if detailErr == nil {
+		st = detailed
+	}
+	return resp, st.Err()
+}
+
+func clientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if id := logging.ContextRequestId(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, RequestIdKey, id)
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, callTimeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func firstMetadata(ctx context.Context, key string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func statusCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPaymentRequired, http.StatusPreconditionFailed, http.StatusUnprocessableEntity, http.StatusPreconditionRequired:
+		return codes.FailedPrecondition
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return codes.Unavailable
+	}
+	return codes.Internal
+}
+
+/*
+This function returns the message of a gRPC error from another microservice,
+so that it can be passed on like the message of a REST error response
+*/
+func Message(err error) string {
+	return status.Convert(err).Message()
+}
+
+/*
+This function returns the HTTP status to respond with for a gRPC error from another microservice,
+the one its service.Error had if it was sent, otherwise the reverse of statusCode
+*/
+func HttpStatus(err error) int {
+	st := status.Convert(err)
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			httpStatus, convErr := strconv.Atoi(info.GetMetadata()[httpStatusKey])
+			if convErr == nil {
+				return httpStatus
+			}
+		}
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	}
+	return http.StatusBadGateway
+}
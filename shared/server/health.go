@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"shared/client"
+	"shared/database"
+	"shared/httputil"
+	"shared/logging"
+	"shared/models"
+)
+
+//Set when building, e.g. go build -ldflags "-X shared/server.buildVersion=1.2.0 -X shared/server.buildCommit=$(git rev-parse --short HEAD)"
+var buildVersion = "dev"
+var buildCommit = "unknown"
+
+var startedAt = time.Now()
+
+const readinessTimeout = 2 * time.Second
+
+/////////////////////////
+//                     //
+//    HTTP Functions   //
+//                     //
+/////////////////////////
+
+//Liveness, the service is running and serving requests
+func GetHealth(w http.ResponseWriter, r *http.Request) {
+	httputil.RespondWith(w, http.StatusOK, map[string]string{"Status": "ok"})
+}
+
+/*
+Readiness, the service can reach db and its schema is at latestSchemaVersion.
+The returned handler responds with 503 Service Unavailable if not
+*/
+func GetReadiness(db *gorm.DB, service string, latestSchemaVersion int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		readiness := newReadiness()
+		readiness.Database = "ok"
+		readiness.LatestSchemaVersion = latestSchemaVersion
+
+		ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+		defer cancel()
+
+		sqlDb, err := db.DB()
+		if err == nil {
+			err = sqlDb.PingContext(ctx)
+		}
+		if err == nil {
+			readiness.SchemaVersion, err = database.SchemaVersion(db.WithContext(ctx), service)
+		}
+		//the detail is only logged, as /readyz can be called by anyone
+		if err != nil {
+			logging.Error("Database unavailable", map[string]interface{}{"requestId": logging.RequestId(r), "error": err.Error()})
+			readiness.Status = "not ready"
+			readiness.Database = "unavailable"
+			httputil.RespondWith(w, http.StatusServiceUnavailable, readiness)
+			return
+		}
+
+		if readiness.SchemaVersion != readiness.LatestSchemaVersion {
+			readiness.Status = "not ready"
+			httputil.RespondWith(w, http.StatusServiceUnavailable, readiness)
+			return
+		}
+
+		httputil.RespondWith(w, http.StatusOK, readiness)
+	}
+}
+
+/*
+Readiness of a service without a database, like the gateway, which is ready if the microservices
+it calls are. backendUrls are any URL of each one by name, e.g. "passenger": "http://localhost:5000".
+The returned handler responds with 503 Service Unavailable if any isn't ready, with why in Backends
+*/
+func GetBackendReadiness(backendUrls map[string]string) http.HandlerFunc {
+	readinessClient := client.Client{HttpClient: &http.Client{Timeout: readinessTimeout}}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		readiness := newReadiness()
+		readiness.Backends = map[string]string{}
+
+		var wait sync.WaitGroup
+		var mutex sync.Mutex
+		for name, url := range backendUrls {
+			wait.Add(1)
+			go func(name string, url string) {
+				defer wait.Done()
+				backendReadiness, err := readinessClient.GetReadiness(r.Context(), url)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				readiness.Backends[name] = "ok"
+				if err != nil {
+					readiness.Status = "not ready"
+					readiness.Backends[name] = notReadyReason(backendReadiness, err)
+				}
+			}(name, url)
+		}
+		wait.Wait()
+
+		if readiness.Status != "ready" {
+			httputil.RespondWith(w, http.StatusServiceUnavailable, readiness)
+			return
+		}
+		httputil.RespondWith(w, http.StatusOK, readiness)
+	}
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func newReadiness() models.Readiness {
+	return models.Readiness{
+		Status: "ready",
+		Build: models.BuildInfo{
+			Version:   buildVersion,
+			Commit:    buildCommit,
+			GoVersion: runtime.Version(),
+		},
+		StartedAt: startedAt,
+		Uptime:    time.Since(startedAt).Round(time.Second).String(),
+	}
+}
+
+//Returns why a microservice that responded to /readyz with readiness and err isn't ready
+func notReadyReason(readiness models.Readiness, err error) string {
+	if readiness.Database != "" && readiness.Database != "ok" {
+		return "database " + readiness.Database
+	}
+	if readiness.SchemaVersion != readiness.LatestSchemaVersion {
+		return fmt.Sprintf("database schema is at version %d but %d is needed", readiness.SchemaVersion, readiness.LatestSchemaVersion)
+	}
+	return err.Error()
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+
+	"shared/database"
+	"shared/logging"
+	"shared/settings"
+	"shared/tracing"
+)
+
+//Background work started with RunEvery, which is let finish before the database is closed
+var background sync.WaitGroup
+
+//Closed on shutdown to stop RunEvery from starting more work
+var stopping = make(chan struct{})
+
+/*
+This function serves handler on the configured port and grpcServer on the gRPC port until SIGINT or SIGTERM.
+It then stops accepting connections, lets in-flight requests and background work finish
+within SHUTDOWN_TIMEOUT and closes db.
+grpcServer and db are nil for services without them, like the gateway
+*/
+func Start(name string, handler http.Handler, grpcServer *grpc.Server, service settings.Service, db *gorm.DB) {
+	server := &http.Server{
+		Addr:         fmt.Sprintf(":%d", service.Port),
+		Handler:      handler,
+		ReadTimeout:  service.ReadTimeout,
+		WriteTimeout: service.WriteTimeout,
+		IdleTimeout:  service.IdleTimeout,
+	}
+
+	serverErr := make(chan error, 2)
+	go func() {
+		serverErr <- server.ListenAndServe()
+	}()
+	fields := map[string]interface{}{"port": service.Port}
+
+	if grpcServer != nil {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", service.GrpcPort))
+		if err != nil {
+			panic("Listening for gRPC failed with error: " + err.Error())
+		}
+		go func() {
+			serverErr <- grpcServer.Serve(listener)
+		}()
+		fields["grpcPort"] = service.GrpcPort
+	}
+	logging.Info(name+" Microservice running", fields)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err := <-serverErr:
+		panic("InitRouter failed with error: " + err.Error())
+	case received := <-signals:
+		//a second signal kills the service straight away
+		signal.Stop(signals)
+		logging.Info("Shutting down "+name+" Microservice", map[string]interface{}{"signal": received.String()})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), service.ShutdownTimeout)
+	defer cancel()
+
+	err := server.Shutdown(ctx)
+	if err != nil {
+		logging.Error("In-flight requests were cut off", map[string]interface{}{"error": err.Error()})
+	}
+	if grpcServer != nil {
+		stopGrpc(ctx, grpcServer)
+	}
+
+	close(stopping)
+	drained := make(chan struct{})
+	go func() {
+		background.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		logging.Error("Background work was cut off", map[string]interface{}{"error": ctx.Err().Error()})
+	}
+
+	if db != nil {
+		database.Close(db)
+	}
+	tracing.Shutdown(ctx)
+	logging.Info(name+" Microservice stopped", nil)
+}
+
+//Lets in-flight gRPC calls finish, cutting them off if ctx ends first
+func stopGrpc(ctx context.Context, grpcServer *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+		logging.Error("In-flight gRPC calls were cut off", map[string]interface{}{"error": ctx.Err().Error()})
+	}
+}
+
+/*
+This function runs work every interval in the background until the service shuts down
+*/
+func RunEvery(interval time.Duration, work func()) {
+	background.Add(1)
+	go func() {
+		defer background.Done()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				work()
+			case <-stopping:
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+/*
+Helpers for the microservices' service functions, which do what a route does whether
+it was called over REST or gRPC. They return an Error for anything the caller got wrong
+*/
+
+//Error has the HTTP status that REST requests are responded with, gRPC requests get the matching code
+type Error struct {
+	Status  int
+	Message string
+}
+
+func (err *Error) Error() string {
+	return err.Message
+}
+
+func NewError(status int, message string) error {
+	return &Error{Status: status, Message: message}
+}
+
+//Returns the first error that isn't nil, so that checks can be made in order
+func FirstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Returns an error if data has a zero value
+func RequireField(data interface{}, fieldName string) error {
+	if reflect.ValueOf(data).IsZero() {
+		return NewError(http.StatusBadRequest, fmt.Sprintf("%s field is missing/incorrect.", fieldName))
+	}
+	return nil
+}
+
+/////////////////////////
+//                     //
+//        Admin        //
+//                     //
+/////////////////////////
+
+type contextKey string
+
+const adminKey contextKey = "admin"
+
+//Calls made with the returned context can do what only admins can, set from the admin password of a request
+func WithAdmin(ctx context.Context, admin bool) context.Context {
+	return context.WithValue(ctx, adminKey, admin)
+}
+
+func IsAdmin(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminKey).(bool)
+	return admin
+}
+
+func RequireAdmin(ctx context.Context) error {
+	if !IsAdmin(ctx) {
+		return NewError(http.StatusForbidden, "Unauthorized User")
+	}
+	return nil
+}
+
+/////////////////////////
+//                     //
+//      Versioning     //
+//                     //
+/////////////////////////
+
+//The versions a write expects a versioned row to be at, so that a client can't overwrite a change it hasn't seen
+type IfMatch struct {
+	given    bool
+	any      bool
+	versions []int
+	missing  string //why no version was given
+}
+
+//Reads a REST request's If-Match header, which has the ETags sent with the row or "*"
+func IfMatchHeader(header string) IfMatch {
+	ifMatch := IfMatch{given: header != "", missing: "If-Match header is required"}
+	if header == "*" {
+		ifMatch.any = true
+		return ifMatch
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		version, err := strconv.Atoi(strings.Trim(strings.TrimSpace(tag), "\""))
+		if err == nil {
+			ifMatch.versions = append(ifMatch.versions, version)
+		}
+	}
+	return ifMatch
+}
+
+//For a gRPC request's Version, 0 if it wasn't given
+func IfMatchVersion(version int) IfMatch {
+	ifMatch := IfMatch{given: version != 0, missing: "Version is required"}
+	if version != 0 {
+		ifMatch.versions = []int{version}
+	}
+	return ifMatch
+}
+
+//Returns an error unless the row's current version is one that was expected
+func (ifMatch IfMatch) Check(version int) error {
+	if !ifMatch.given {
+		return NewError(http.StatusPreconditionRequired, ifMatch.missing)
+	}
+	if ifMatch.any {
+		return nil
+	}
+	for _, expected := range ifMatch.versions {
+		if expected == version {
+			return nil
+		}
+	}
+	return VersionChanged()
+}
+
+//For when the row changed between checking its version and writing
+func VersionChanged() error {
+	return NewError(http.StatusPreconditionFailed, "Changed by someone else, get the latest version and try again")
+}
@@ -0,0 +1,298 @@
+package settings
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"github.com/joho/godotenv"
+)
+
+/*
+Settings are layered, each overriding the one before: defaults, the config file,
+environment variables and then command line flags.
+The config file has a KEY=value per line, the same names as the environment variables.
+The flag of each setting is its name in lower case with "-" instead of "_", e.g. --passenger-port
+*/
+type Setting struct {
+	Name   string
+	Value  interface{} //pointer to the config field, a *string, *int, *bool or *time.Duration
+	Usage  string
+	Redact func(value string) string //hides secrets from --print-config
+
+	source string //where the value came from
+}
+
+/*
+This function loads settings into the config fields they point to and returns the arguments left after the flags.
+The fields should already hold the defaults. It stops the program if validate finds problems,
+or after printing the config for --print-config
+*/
+func Load(defaultConfigFile string, settings []*Setting, validate func() []string) []string {
+	flags := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	configFile := flags.String("config", "", "config file to load (default "+defaultConfigFile+", or CONFIG_FILE)")
+	printConfig := flags.Bool("print-config", false, "print the config with secrets redacted and exit")
+	flagValues := map[string]*string{}
+	for _, s := range settings {
+		s.source = "default"
+		flagValues[s.Name] = flags.String(Flag(s.Name), "", s.Usage)
+	}
+	flags.Parse(os.Args[1:])
+
+	setFlags := map[string]bool{}
+	flags.Visit(func(f *flag.Flag) {
+		setFlags[f.Name] = true
+	})
+
+	//a missing config file is only an error if one was asked for
+	path := *configFile
+	if path == "" {
+		path = os.Getenv("CONFIG_FILE")
+	}
+	fileValues := map[string]string{}
+	if path != "" {
+		var err error
+		fileValues, err = godotenv.Read(path)
+		if err != nil {
+			log.Fatal("Error loading config file: " + err.Error())
+		}
+	} else if values, err := godotenv.Read(defaultConfigFile); err == nil {
+		fileValues = values
+	} else if !os.IsNotExist(err) {
+		log.Fatal("Error loading config file: " + err.Error())
+	}
+
+	problems := []string{}
+	for _, s := range settings {
+		value, source := "", ""
+		if fileValue, ok := fileValues[s.Name]; ok {
+			value, source = fileValue, "file"
+		}
+		if envValue, ok := os.LookupEnv(s.Name); ok {
+			value, source = envValue, "env"
+		}
+		if setFlags[Flag(s.Name)] {
+			value, source = *flagValues[s.Name], "flag"
+		}
+		if source == "" {
+			continue
+		}
+
+		s.source = source
+		err := s.set(value)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s from %s: %s", s.Name, source, err.Error()))
+		}
+	}
+	if len(problems) == 0 {
+		problems = validate()
+	}
+
+	if *printConfig {
+		for _, s := range settings {
+			fmt.Printf("%s=%s (%s)\n", s.Name, s.redacted(), s.source)
+		}
+		if len(problems) > 0 {
+			fmt.Println("\nInvalid config:\n  " + strings.Join(problems, "\n  "))
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	if len(problems) > 0 {
+		log.Fatal("Invalid config:\n  " + strings.Join(problems, "\n  "))
+	}
+
+	return flags.Args()
+}
+
+/////////////////////////
+//                     //
+//       Service       //
+//                     //
+/////////////////////////
+
+//Settings every microservice has, embedded in each one's Config
+type Service struct {
+	Port                 int
+	GrpcPort             int
+	ReadTimeout          time.Duration
+	WriteTimeout         time.Duration
+	IdleTimeout          time.Duration
+	ShutdownTimeout      time.Duration
+	Dsn                  string
+	AdminPassword        string
+	DeletedRetentionDays int
+	TraceExporter        string
+	OtlpEndpoint         string
+	OtlpInsecure         bool
+}
+
+func DefaultService(port int) Service {
+	return Service{
+		Port:                 port,
+		GrpcPort:             port + 1000,
+		ReadTimeout:          30 * time.Second,
+		WriteTimeout:         30 * time.Second,
+		IdleTimeout:          2 * time.Minute,
+		ShutdownTimeout:      20 * time.Second,
+		DeletedRetentionDays: 30,
+		TraceExporter:        "none",
+		OtlpEndpoint:         "localhost:4318",
+		OtlpInsecure:         true,
+	}
+}
+
+/*
+This function returns the settings of service, with the port named portName, e.g. PASSENGER_PORT,
+and the gRPC port named after it, e.g. PASSENGER_GRPC_PORT.
+deletedRows says what DELETED_RETENTION_DAYS keeps, e.g. "passengers"
+*/
+func (service *Service) Settings(portName string, deletedRows string) []*Setting {
+	return append(service.ServerSettings(portName),
+		&Setting{Name: grpcPortName(portName), Value: &service.GrpcPort, Usage: "port to serve gRPC on"},
+		&Setting{Name: "DSN", Value: &service.Dsn, Usage: "MySQL data source name", Redact: RedactDsn},
+		&Setting{Name: "DELETED_RETENTION_DAYS", Value: &service.DeletedRetentionDays, Usage: "days deleted " + deletedRows + " can be restored for"},
+	)
+}
+
+//Returns the settings of a service without a database or gRPC server, like the gateway
+func (service *Service) ServerSettings(portName string) []*Setting {
+	return []*Setting{
+		{Name: portName, Value: &service.Port, Usage: "port to listen on"},
+		{Name: "READ_TIMEOUT", Value: &service.ReadTimeout, Usage: "longest a request can take to be read, e.g. 30s"},
+		{Name: "WRITE_TIMEOUT", Value: &service.WriteTimeout, Usage: "longest a response can take to be written"},
+		{Name: "IDLE_TIMEOUT", Value: &service.IdleTimeout, Usage: "how long idle keep-alive connections are kept"},
+		{Name: "SHUTDOWN_TIMEOUT", Value: &service.ShutdownTimeout, Usage: "how long in-flight requests and background work get to finish on shutdown"},
+		{Name: "ADMIN_PASSWORD", Value: &service.AdminPassword, Usage: "password for admin only requests", Redact: RedactSecret},
+		{Name: "TRACE_EXPORTER", Value: &service.TraceExporter, Usage: "where spans are sent, \"none\", \"stdout\" or \"otlp\""},
+		{Name: "OTLP_ENDPOINT", Value: &service.OtlpEndpoint, Usage: "host:port of the OTLP/HTTP collector"},
+		{Name: "OTLP_INSECURE", Value: &service.OtlpInsecure, Usage: "send spans to the collector without TLS"},
+	}
+}
+
+//Returns a message for each invalid setting of service
+func (service *Service) Validate(portName string) []string {
+	problems := service.ValidateServer(portName)
+	if service.GrpcPort < 1 || service.GrpcPort > 65535 || service.GrpcPort == service.Port {
+		problems = append(problems, grpcPortName(portName)+" must be between 1 and 65535 and not "+portName)
+	}
+	if service.Dsn == "" {
+		problems = append(problems, "DSN is required")
+	}
+	if service.DeletedRetentionDays < 0 {
+		problems = append(problems, "DELETED_RETENTION_DAYS can't be negative")
+	}
+	return problems
+}
+
+//Returns a message for each invalid setting of ServerSettings
+func (service *Service) ValidateServer(portName string) []string {
+	problems := []string{}
+	if service.Port < 1 || service.Port > 65535 {
+		problems = append(problems, portName+" must be between 1 and 65535")
+	}
+	if service.ReadTimeout <= 0 || service.WriteTimeout <= 0 || service.IdleTimeout <= 0 || service.ShutdownTimeout <= 0 {
+		problems = append(problems, "READ_TIMEOUT, WRITE_TIMEOUT, IDLE_TIMEOUT and SHUTDOWN_TIMEOUT must be more than 0")
+	}
+	if service.AdminPassword == "" {
+		problems = append(problems, "ADMIN_PASSWORD is required")
+	}
+	if service.TraceExporter != "none" && service.TraceExporter != "stdout" && service.TraceExporter != "otlp" {
+		problems = append(problems, "TRACE_EXPORTER must be \"none\", \"stdout\" or \"otlp\"")
+	}
+	if service.TraceExporter == "otlp" && service.OtlpEndpoint == "" {
+		problems = append(problems, "OTLP_ENDPOINT is required for the otlp exporter")
+	}
+	return problems
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+func (s *Setting) set(value string) error {
+	switch field := s.Value.(type) {
+	case *string:
+		*field = value
+	case *int:
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a whole number", value)
+		}
+		*field = number
+	case *bool:
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%q is not true or false", value)
+		}
+		*field = boolean
+	case *time.Duration:
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a duration like 30s or 2m", value)
+		}
+		*field = duration
+	}
+	return nil
+}
+
+func (s *Setting) String() string {
+	switch field := s.Value.(type) {
+	case *string:
+		return *field
+	case *int:
+		return strconv.Itoa(*field)
+	case *bool:
+		return strconv.FormatBool(*field)
+	case *time.Duration:
+		return field.String()
+	}
+	return ""
+}
+
+func (s *Setting) redacted() string {
+	value := s.String()
+	if s.Redact == nil || value == "" {
+		return value
+	}
+	return s.Redact(value)
+}
+
+//e.g. PASSENGER_GRPC_PORT for PASSENGER_PORT
+func grpcPortName(portName string) string {
+	return strings.TrimSuffix(portName, "_PORT") + "_GRPC_PORT"
+}
+
+//Returns the flag of the setting named name
+func Flag(name string) string {
+	return strings.ReplaceAll(strings.ToLower(name), "_", "-")
+}
+
+func RedactSecret(value string) string {
+	return "[redacted]"
+}
+
+//Keeps the user, host and database so that it's clear which database is used
+func RedactDsn(dsn string) string {
+	dsnConfig, err := mysqlDriver.ParseDSN(dsn)
+	if err != nil {
+		return "[redacted]"
+	}
+	if dsnConfig.Passwd != "" {
+		dsnConfig.Passwd = "redacted"
+	}
+	return dsnConfig.FormatDSN()
+}
+
+func IsHttpUrl(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
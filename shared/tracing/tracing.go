@@ -0,0 +1,147 @@
+package tracing
+
+import (
+	"context"
+	"log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"shared/logging"
+	"shared/settings"
+)
+
+var tracer trace.Tracer
+
+var tracerProvider *sdktrace.TracerProvider
+
+/*
+This function sets up where spans are sent, given by TRACE_EXPORTER:
+"none" to only pass trace context on, "stdout" to print spans for local debugging
+or "otlp" to send them to a collector at OTLP_ENDPOINT.
+Trace context is read from and sent in W3C traceparent headers
+*/
+func Init(serviceName string, service settings.Service) {
+	tracer = otel.Tracer(serviceName)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	}
+	switch service.TraceExporter {
+	case "none":
+	case "stdout":
+		exporter, err := stdouttrace.New()
+		if err != nil {
+			log.Fatal("Failed to create stdout trace exporter: " + err.Error())
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+	case "otlp":
+		otlpOptions := []otlptracehttp.Option{otlptracehttp.WithEndpoint(service.OtlpEndpoint)}
+		if service.OtlpInsecure {
+			otlpOptions = append(otlpOptions, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(context.Background(), otlpOptions...)
+		if err != nil {
+			log.Fatal("Failed to create OTLP trace exporter: " + err.Error())
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+	default:
+		log.Fatal("Unknown TRACE_EXPORTER: " + service.TraceExporter)
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(tracerProvider)
+}
+
+//Sends spans that haven't been sent yet
+func Shutdown(ctx context.Context) {
+	if tracerProvider == nil {
+		return
+	}
+	err := tracerProvider.Shutdown(ctx)
+	if err != nil {
+		logging.Error("Failed to send remaining spans", map[string]interface{}{"error": err.Error()})
+	}
+}
+
+/*
+This function makes a span for every database call made with a traced context,
+e.g. db.WithContext(r.Context()) in a HTTP handler
+*/
+func RegisterCallbacks(db *gorm.DB) {
+	callbacks := db.Callback()
+	callbacks.Create().Before("gorm:create").Register("otel:before_create", startDbSpan("create"))
+	callbacks.Create().After("gorm:create").Register("otel:after_create", endDbSpan)
+	callbacks.Query().Before("gorm:query").Register("otel:before_query", startDbSpan("query"))
+	callbacks.Query().After("gorm:query").Register("otel:after_query", endDbSpan)
+	callbacks.Update().Before("gorm:update").Register("otel:before_update", startDbSpan("update"))
+	callbacks.Update().After("gorm:update").Register("otel:after_update", endDbSpan)
+	callbacks.Delete().Before("gorm:delete").Register("otel:before_delete", startDbSpan("delete"))
+	callbacks.Delete().After("gorm:delete").Register("otel:after_delete", endDbSpan)
+	callbacks.Row().Before("gorm:row").Register("otel:before_row", startDbSpan("row"))
+	callbacks.Row().After("gorm:row").Register("otel:after_row", endDbSpan)
+	callbacks.Raw().Before("gorm:raw").Register("otel:before_raw", startDbSpan("raw"))
+	callbacks.Raw().After("gorm:raw").Register("otel:after_raw", endDbSpan)
+}
+
+/////////////////////////
+//                     //
+//       Helpers       //
+//                     //
+/////////////////////////
+
+const dbSpanKey = "otel:span"
+
+type dbSpan struct {
+	span          trace.Span
+	parentContext context.Context
+}
+
+func startDbSpan(operation string) func(tx *gorm.DB) {
+	return func(tx *gorm.DB) {
+		//calls outside of a request, like the monitors', aren't part of a trace
+		if tracer == nil || !trace.SpanContextFromContext(tx.Statement.Context).IsValid() {
+			return
+		}
+
+		parentContext := tx.Statement.Context
+		ctx, span := tracer.Start(parentContext, "gorm."+operation, trace.WithSpanKind(trace.SpanKindClient))
+		tx.Statement.Context = ctx
+		tx.InstanceSet(dbSpanKey, dbSpan{span: span, parentContext: parentContext})
+	}
+}
+
+func endDbSpan(tx *gorm.DB) {
+	value, ok := tx.InstanceGet(dbSpanKey)
+	if !ok {
+		return
+	}
+	started := value.(dbSpan)
+	span := started.span
+
+	//a statement can be run again, e.g. a query built once and used for Count and Find
+	tx.Statement.Context = started.parentContext
+
+	//SQL is kept with placeholders, so values such as emails aren't in the span
+	span.SetAttributes(
+		semconv.DBSystemMySQL,
+		semconv.DBSQLTableKey.String(tx.Statement.Table),
+		semconv.DBStatementKey.String(tx.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", tx.RowsAffected),
+	)
+	if tx.Error != nil && tx.Error != gorm.ErrRecordNotFound {
+		span.RecordError(tx.Error)
+		span.SetStatus(codes.Error, tx.Error.Error())
+	}
+	span.End()
+}